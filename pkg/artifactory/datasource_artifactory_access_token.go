@@ -0,0 +1,100 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const accessTokenInfoUrl = "access/api/v1/tokens/{tokenId}"
+
+// AccessTokenInfo is the metadata the Access API returns for a token, it never includes the token itself
+type AccessTokenInfo struct {
+	TokenId     string `json:"token_id"`
+	Subject     string `json:"subject"`
+	Scope       string `json:"scope"`
+	Expiry      int    `json:"expiry"`
+	IssuedAt    int    `json:"issued_at"`
+	Issuer      string `json:"issuer"`
+	Description string `json:"description"`
+	Refreshable bool   `json:"refreshable"`
+}
+
+func dataSourceArtifactoryAccessToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccessTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"token_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the token expires at, in seconds since the epoch. 0 if the token doesn't expire",
+			},
+			"issued_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the token was issued at, in seconds since the epoch",
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"refreshable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAccessTokenRead(d *schema.ResourceData, m interface{}) error {
+	tokenId := d.Get("token_id").(string)
+
+	token := AccessTokenInfo{}
+	resp, err := m.(*resty.Client).R().
+		SetPathParam("tokenId", tokenId).
+		SetResult(&token).
+		AddRetryCondition(neverRetry).
+		Get(accessTokenInfoUrl)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return fmt.Errorf("access token %s does not exist", tokenId)
+		}
+		return fmt.Errorf("failed to retrieve access token %s: %s", tokenId, err)
+	}
+
+	d.SetId(tokenId)
+	setValue := mkLens(d)
+	setValue("subject", token.Subject)
+	setValue("scope", token.Scope)
+	setValue("expiry", token.Expiry)
+	setValue("issued_at", token.IssuedAt)
+	setValue("issuer", token.Issuer)
+	setValue("description", token.Description)
+	errors := setValue("refreshable", token.Refreshable)
+	if errors != nil && len(errors) > 0 {
+		return fmt.Errorf("failed to pack access token %q", errors)
+	}
+	return nil
+}
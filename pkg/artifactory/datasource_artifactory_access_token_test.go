@@ -0,0 +1,63 @@
+package artifactory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceAccessTokenRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/access/api/v1/tokens/token-id" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"token_id":     "token-id",
+			"subject":      "jfrt@01abc/users/admin",
+			"scope":        "applied-permissions/user",
+			"expiry":       1700000000,
+			"issued_at":    1600000000,
+			"refreshable":  true,
+			"access_token": "secret",
+		})
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataSource := dataSourceArtifactoryAccessToken()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"token_id": "token-id"})
+	if err := dataSource.Read(d, client.SetRetryCount(0)); err != nil {
+		t.Fatalf("failed to read the access token: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"subject":     "jfrt@01abc/users/admin",
+		"scope":       "applied-permissions/user",
+		"expiry":      1700000000,
+		"issued_at":   1600000000,
+		"refreshable": true,
+	}
+	for key, value := range expected {
+		if d.Get(key) != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, d.Get(key))
+		}
+	}
+	if _, ok := dataSource.Schema["access_token"]; ok {
+		t.Error("the token itself must never be exposed")
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"token_id": "unknown"})
+	if err := dataSource.Read(d, client); err == nil || !strings.Contains(err.Error(), "access token unknown does not exist") {
+		t.Errorf("expected a missing token error, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package artifactory
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceArtifactoryBackup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cron_exp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retention_period_hours": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"excluded_repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_archive": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"exclude_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"send_mail_on_error": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBackupRead(d *schema.ResourceData, m interface{}) error {
+	key := d.Get("key").(string)
+
+	backups := Backups{}
+	_, err := m.(*resty.Client).R().SetResult(&backups).Get("artifactory/api/system/configuration")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration: %s", err)
+	}
+
+	for _, backup := range backups.BackupArr {
+		if backup.Key != key {
+			continue
+		}
+
+		d.SetId(backup.Key)
+		setValue := mkLens(d)
+		setValue("enabled", backup.Enabled)
+		setValue("cron_exp", backup.CronExp)
+		setValue("retention_period_hours", backup.RetentionPeriodHours)
+		setValue("excluded_repositories", backup.ExcludedRepositories)
+		setValue("create_archive", backup.CreateArchive)
+		setValue("exclude_new_repositories", backup.ExcludeNewRepositories)
+		errors := setValue("send_mail_on_error", backup.SendMailOnError)
+		if errors != nil && len(errors) > 0 {
+			return fmt.Errorf("failed to pack backup %q", errors)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("backup %s does not exist", key)
+}
@@ -0,0 +1,44 @@
+package artifactory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBackup(t *testing.T) {
+	_, fqrn, name := mkNames("backup", "artifactory_backup")
+	config := fmt.Sprintf(`
+		resource "artifactory_backup" "%s" {
+			key                    = "%s"
+			enabled                = false
+			cron_exp               = "0 0 12 * * ?"
+			retention_period_hours = 1000
+		}
+
+		data "artifactory_backup" "%s" {
+			key = artifactory_backup.%s.key
+		}
+	`, name, name, name, name)
+	dataFqrn := "data.artifactory_backup." + name
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccBackupDestroy(name),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataFqrn, "key", fqrn, "key"),
+					resource.TestCheckResourceAttr(dataFqrn, "enabled", "false"),
+					resource.TestCheckResourceAttr(dataFqrn, "cron_exp", "0 0 12 * * ?"),
+					resource.TestCheckResourceAttr(dataFqrn, "retention_period_hours", "1000"),
+					resource.TestCheckResourceAttr(dataFqrn, "excluded_repositories.#", "0"),
+					resource.TestCheckResourceAttr(dataFqrn, "send_mail_on_error", "true"),
+				),
+			},
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -53,6 +54,43 @@ func TestDlFile(t *testing.T) {
 		},
 	})
 }
+func TestFileInfoExpectedSha256(t *testing.T) {
+	const crashZipSha256 = "7a2489dd209d0acb72f7f11d171b418e65648b9cc96c6c351e00e22551fdd8f1"
+	const fileInfo = `
+		data "artifactory_fileinfo" "example" {
+		  repository      = "example-repo-local"
+		  path            = "crash.zip"
+		  expected_sha256 = "%s"
+		}
+	`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			client := getTestResty(t)
+			err := uploadTestFile(client, "../../samples/crash.zip", "example-repo-local/crash.zip", "application/zip")
+			if err != nil {
+				panic(err)
+			}
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(fileInfo, crashZipSha256),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactory_fileinfo.example", "sha256", crashZipSha256),
+					resource.TestCheckResourceAttrSet("data.artifactory_fileinfo.example", "sha1"),
+					resource.TestCheckResourceAttrSet("data.artifactory_fileinfo.example", "md5"),
+					resource.TestCheckResourceAttrSet("data.artifactory_fileinfo.example", "download_uri"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(fileInfo, "0000000000000000000000000000000000000000000000000000000000000000"),
+				ExpectError: regexp.MustCompile(".*checksum mismatch for example-repo-local/crash.zip.*"),
+			},
+		},
+	})
+}
+
 func TestFileExists(t *testing.T) {
 	tmpFile, err := CreateTempFile("test")
 
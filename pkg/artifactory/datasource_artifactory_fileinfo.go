@@ -2,10 +2,13 @@ package artifactory
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceArtifactoryFileInfo() *schema.Resource {
@@ -22,6 +25,12 @@ func dataSourceArtifactoryFileInfo() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"expected_sha256": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[a-fA-F0-9]{64}$"), "must be a SHA256 checksum"),
+				Description:  "When set, reading the data source fails if the SHA256 checksum of the stored file doesn't match.",
+			},
 			"created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -80,6 +89,10 @@ func dataSourceFileInfoRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if expected, ok := d.GetOk("expected_sha256"); ok && !strings.EqualFold(expected.(string), fileInfo.Checksums.Sha256) {
+		return fmt.Errorf("checksum mismatch for %s/%s: expected sha256 %s but Artifactory has %s", repository, path, expected, fileInfo.Checksums.Sha256)
+	}
+
 	return packFileInfo(fileInfo, d)
 }
 
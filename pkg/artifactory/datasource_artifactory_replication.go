@@ -0,0 +1,122 @@
+package artifactory
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceArtifactoryReplication() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: repoKeyValidator,
+			},
+			"replications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cron_exp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_event_replication": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sync_deletes": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sync_properties": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sync_statistics": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"path_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"proxy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReplicationRead(d *schema.ResourceData, m interface{}) error {
+	repoKey := d.Get("repo_key").(string)
+
+	resp, err := m.(*resty.Client).R().Get(replicationEndpoint + repoKey)
+	if err != nil {
+		return err
+	}
+
+	// pull replications (remote repos) come back as a single object, push replications (local repos) as an array
+	var replications []getReplicationBody
+	if err = json.Unmarshal(resp.Body(), &replications); err != nil {
+		replication := getReplicationBody{}
+		if err = json.Unmarshal(resp.Body(), &replication); err != nil {
+			return err
+		}
+		replications = []getReplicationBody{replication}
+	}
+
+	return packReplications(repoKey, replications, d)
+}
+
+func packReplications(repoKey string, replications []getReplicationBody, d *schema.ResourceData) error {
+	d.SetId(repoKey)
+
+	var packed []map[string]interface{}
+	for _, replication := range replications {
+		// the password is deliberately left out, it only ever comes back encrypted
+		packed = append(packed, map[string]interface{}{
+			"url":                      replication.URL,
+			"username":                 replication.Username,
+			"cron_exp":                 replication.CronExp,
+			"enable_event_replication": replication.EnableEventReplication,
+			"enabled":                  replication.Enabled,
+			"sync_deletes":             replication.SyncDeletes,
+			"sync_properties":          replication.SyncProperties,
+			"sync_statistics":          replication.SyncStatistics,
+			"path_prefix":              replication.PathPrefix,
+			"proxy":                    replication.ProxyRef,
+		})
+	}
+
+	setValue := mkLens(d)
+	errors := setValue("replications", packed)
+	if errors != nil && len(errors) > 0 {
+		return fmt.Errorf("failed to pack replications %q", errors)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package artifactory
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceReplication(t *testing.T) {
+	_, fqrn, name := mkNames("lib-local", "artifactory_pull_replication")
+	config := mkTclForPullRepConfg(name, "0 0 * * * ?", os.Getenv("ARTIFACTORY_URL")) + fmt.Sprintf(`
+		data "artifactory_replication" "%s" {
+			repo_key = artifactory_pull_replication.%s.repo_key
+		}
+	`, name, name)
+	dataFqrn := "data.artifactory_replication." + name
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccCheckReplicationDestroy(fqrn),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataFqrn, "repo_key", name),
+					resource.TestCheckResourceAttr(dataFqrn, "replications.#", "1"),
+					resource.TestCheckResourceAttr(dataFqrn, "replications.0.cron_exp", "0 0 * * * ?"),
+					resource.TestCheckResourceAttr(dataFqrn, "replications.0.enable_event_replication", "true"),
+					resource.TestCheckNoResourceAttr(dataFqrn, "replications.0.password"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package artifactory
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const storageInfoUrl = "artifactory/api/storageinfo"
+
+type RepositoryStorageSummary struct {
+	RepoKey      string `json:"repoKey"`
+	UsedSpace    string `json:"usedSpace"`
+	FilesCount   int    `json:"filesCount"`
+	FoldersCount int    `json:"foldersCount"`
+	ItemsCount   int    `json:"itemsCount"`
+}
+
+type StorageInfo struct {
+	RepositoriesSummaryList []RepositoryStorageSummary `json:"repositoriesSummaryList"`
+}
+
+func dataSourceArtifactoryRepositoryStats() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRepositoryStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: repoKeyValidator,
+			},
+			"used_space": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The space used by the repository, as formatted by Artifactory, e.g. '1.21 GB'",
+			},
+			"files_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"folders_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"items_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryStatsRead(d *schema.ResourceData, m interface{}) error {
+	repoKey := d.Get("repo_key").(string)
+
+	storageInfo := StorageInfo{}
+	_, err := m.(*resty.Client).R().SetResult(&storageInfo).Get(storageInfoUrl)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve data from API: /%s: %s", storageInfoUrl, err)
+	}
+
+	for _, summary := range storageInfo.RepositoriesSummaryList {
+		if summary.RepoKey != repoKey {
+			continue
+		}
+
+		d.SetId(summary.RepoKey)
+		setValue := mkLens(d)
+		setValue("used_space", summary.UsedSpace)
+		setValue("files_count", summary.FilesCount)
+		setValue("folders_count", summary.FoldersCount)
+		errors := setValue("items_count", summary.ItemsCount)
+		if errors != nil && len(errors) > 0 {
+			return fmt.Errorf("failed to pack repository stats %q", errors)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("repository %s does not exist", repoKey)
+}
@@ -0,0 +1,57 @@
+package artifactory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceRepositoryStatsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/storageinfo" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"repositoriesSummaryList": []map[string]interface{}{
+				{"repoKey": "other-local", "usedSpace": "1 bytes", "filesCount": 1, "foldersCount": 1, "itemsCount": 2},
+				{"repoKey": "libs-local", "usedSpace": "1.21 GB", "filesCount": 120, "foldersCount": 30, "itemsCount": 150},
+				{"repoKey": "TOTAL", "usedSpace": "1.21 GB", "filesCount": 121, "foldersCount": 31, "itemsCount": 152},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataSource := dataSourceArtifactoryRepositoryStats()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"repo_key": "libs-local"})
+	if err := dataSource.Read(d, client.SetRetryCount(0)); err != nil {
+		t.Fatalf("failed to read the repository stats: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"used_space":    "1.21 GB",
+		"files_count":   120,
+		"folders_count": 30,
+		"items_count":   150,
+	}
+	for key, value := range expected {
+		if d.Get(key) != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, d.Get(key))
+		}
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"repo_key": "unknown-local"})
+	if err := dataSource.Read(d, client); err == nil || !strings.Contains(err.Error(), "repository unknown-local does not exist") {
+		t.Errorf("expected a missing repository error, got %v", err)
+	}
+}
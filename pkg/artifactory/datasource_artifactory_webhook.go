@@ -0,0 +1,176 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceArtifactoryWebhook() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWebhookRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(webhookTypesSupported, false),
+				Description:  fmt.Sprintf("The domain of the webhook, one of: %v", webhookTypesSupported),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proxy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_http_headers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// only the attributes of the criteria of the domain are set, the criteria block of every domain fits in here
+			"criteria": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_patterns": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exclude_patterns": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"any_local": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"any_remote": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"repo_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"any_build": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"selected_builds": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"any_release_bundle": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"registered_release_bundle_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"selected_release_bundles": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWebhookRead(d *schema.ResourceData, m interface{}) error {
+	key := d.Get("key").(string)
+	webhookType := d.Get("type").(string)
+
+	webhook := WebhookBaseParams{}
+	resp, err := m.(*resty.Client).R().
+		SetPathParam("webhookKey", key).
+		SetResult(&webhook).
+		AddRetryCondition(neverRetry).
+		Get(webhookUrl)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return fmt.Errorf("webhook %s does not exist", key)
+		}
+		return fmt.Errorf("failed to retrieve webhook %s: %s", key, err)
+	}
+	// the key is unique across the domains, so a webhook of another domain is reported rather than read
+	if webhook.EventFilter.Domain != webhookType {
+		return fmt.Errorf("webhook %s does not exist for domain %s, its domain is %s", key, webhookType, webhook.EventFilter.Domain)
+	}
+
+	d.SetId(webhook.Id())
+	return packWebhookDataSource(d, webhook)
+}
+
+func packWebhookDataSource(d *schema.ResourceData, webhook WebhookBaseParams) error {
+	setValue := mkLens(d)
+
+	var errors []error
+	errors = append(errors, setValue("description", webhook.Description)...)
+	errors = append(errors, setValue("enabled", webhook.Enabled)...)
+	errors = append(errors, setValue("event_types", webhook.EventFilter.EventTypes)...)
+
+	if criteria, ok := webhook.EventFilter.Criteria.(map[string]interface{}); ok {
+		packedCriteria := domainCriteriaPackLookup[webhook.EventFilter.Domain](criteria)
+		if includePatterns, ok := criteria["includePatterns"].([]interface{}); ok {
+			packedCriteria["include_patterns"] = schema.NewSet(schema.HashString, includePatterns)
+		}
+		if excludePatterns, ok := criteria["excludePatterns"].([]interface{}); ok {
+			packedCriteria["exclude_patterns"] = schema.NewSet(schema.HashString, excludePatterns)
+		}
+		errors = append(errors, setValue("criteria", []interface{}{packedCriteria})...)
+	}
+
+	// the secret is deliberately left out, it is not meant to be shared beyond the webhook
+	if len(webhook.Handlers) > 0 {
+		handler := webhook.Handlers[0]
+		headers := make(map[string]interface{})
+		for _, customHeader := range handler.CustomHttpHeaders {
+			headers[customHeader.Name] = customHeader.Value
+		}
+
+		errors = append(errors, setValue("url", handler.Url)...)
+		errors = append(errors, setValue("proxy", handler.Proxy)...)
+		errors = append(errors, setValue("custom_http_headers", headers)...)
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("failed to pack webhook %q", errors)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package artifactory
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceWebhook(t *testing.T) {
+	_, fqrn, name := mkNames("webhook", "artifactory_artifact_webhook")
+	const template = `
+		resource "artifactory_local_generic_repository" "%[1]s" {
+			key = "%[1]s-local"
+		}
+
+		resource "artifactory_artifact_webhook" "%[1]s" {
+			key         = "%[1]s"
+			event_types = ["deployed", "deleted"]
+			criteria {
+				any_local        = false
+				any_remote       = false
+				repo_keys        = [artifactory_local_generic_repository.%[1]s.key]
+				include_patterns = ["foo/**"]
+			}
+			url    = "http://tempurl.org"
+			secret = "fake-secret"
+
+			custom_http_headers = {
+				header-1 = "value-1"
+			}
+		}
+
+		data "artifactory_webhook" "%[1]s" {
+			key  = artifactory_artifact_webhook.%[1]s.key
+			type = "%[2]s"
+		}
+	`
+	dataFqrn := "data.artifactory_webhook." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckWebhook),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, "artifact"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataFqrn, "key", fqrn, "key"),
+					resource.TestCheckResourceAttr(dataFqrn, "enabled", "true"),
+					resource.TestCheckResourceAttr(dataFqrn, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(dataFqrn, "url", "http://tempurl.org"),
+					resource.TestCheckResourceAttr(dataFqrn, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(dataFqrn, "criteria.0.any_local", "false"),
+					resource.TestCheckTypeSetElemAttr(dataFqrn, "criteria.0.repo_keys.*", name+"-local"),
+					resource.TestCheckTypeSetElemAttr(dataFqrn, "criteria.0.include_patterns.*", "foo/**"),
+					resource.TestCheckResourceAttr(dataFqrn, "custom_http_headers.header-1", "value-1"),
+					resource.TestCheckNoResourceAttr(dataFqrn, "secret"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(template, name, "build"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("webhook %s does not exist for domain build, its domain is artifact", name)),
+			},
+		},
+	})
+}
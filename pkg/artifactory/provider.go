@@ -1,12 +1,17 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,42 +23,81 @@ var Version = "2.6.18"
 // Supported resources are repos, users, groups, replications, and permissions
 func Provider() *schema.Provider {
 	resoucesMap := map[string]*schema.Resource{
-		"artifactory_keypair":                    resourceArtifactoryKeyPair(),
-		"artifactory_local_repository":           resourceArtifactoryLocalRepository(),
-		"artifactory_local_nuget_repository":     resourceArtifactoryLocalNugetRepository(),
-		"artifactory_local_maven_repository":     resourceArtifactoryLocalJavaRepository("maven", false),
-		"artifactory_local_gradle_repository":    resourceArtifactoryLocalJavaRepository("gradle", true),
-		"artifactory_local_alpine_repository":    resourceArtifactoryLocalAlpineRepository(),
-		"artifactory_local_debian_repository":    resourceArtifactoryLocalDebianRepository(),
-		"artifactory_local_docker_v2_repository": resourceArtifactoryLocalDockerV2Repository(),
-		"artifactory_local_docker_v1_repository": resourceArtifactoryLocalDockerV1Repository(),
-		"artifactory_local_rpm_repository":       resourceArtifactoryLocalRpmRepository(),
-		"artifactory_remote_repository":          resourceArtifactoryRemoteRepository(),
-		"artifactory_remote_npm_repository":      resourceArtifactoryRemoteNpmRepository(),
-		"artifactory_remote_docker_repository":   resourceArtifactoryRemoteDockerRepository(),
-		"artifactory_remote_helm_repository":     resourceArtifactoryRemoteHelmRepository(),
-		"artifactory_remote_cargo_repository":    resourceArtifactoryRemoteCargoRepository(),
-		"artifactory_remote_pypi_repository":     resourceArtifactoryRemotePypiRepository(),
-		"artifactory_remote_maven_repository":    resourceArtifactoryRemoteJavaRepository("maven", false),
-		"artifactory_remote_gradle_repository":   resourceArtifactoryRemoteJavaRepository("gradle", true),
-		"artifactory_virtual_repository":         resourceArtifactoryVirtualRepository(),
-		"artifactory_virtual_maven_repository":   resourceArtifactoryMavenVirtualRepository(),
-		"artifactory_virtual_go_repository":      resourceArtifactoryGoVirtualRepository(),
-		"artifactory_virtual_conan_repository":   resourceArtifactoryVirtualRepositoryWithRetrievalCachePeriodSecs("conan"),
-		"artifactory_virtual_rpm_repository":     resourceArtifactoryRpmVirtualRepository(),
-		"artifactory_virtual_generic_repository": resourceArtifactoryVirtualGenericRepository("generic"),
-		"artifactory_virtual_helm_repository":    resourceArtifactoryHelmVirtualRepository(),
-		"artifactory_group":                      resourceArtifactoryGroup(),
-		"artifactory_user":                       resourceArtifactoryUser(),
-		"artifactory_permission_target":          resourceArtifactoryPermissionTarget(),
-		"artifactory_pull_replication":           resourceArtifactoryPullReplication(),
-		"artifactory_push_replication":           resourceArtifactoryPushReplication(),
-		"artifactory_certificate":                resourceArtifactoryCertificate(),
-		"artifactory_api_key":                    resourceArtifactoryApiKey(),
-		"artifactory_access_token":               resourceArtifactoryAccessToken(),
-		"artifactory_general_security":           resourceArtifactoryGeneralSecurity(),
-		"artifactory_oauth_settings":             resourceArtifactoryOauthSettings(),
-		"artifactory_saml_settings":              resourceArtifactorySamlSettings(),
+		"artifactory_keypair":                         resourceArtifactoryKeyPair(),
+		"artifactory_item_properties":                 resourceArtifactoryItemProperties(),
+		"artifactory_local_repository":                resourceArtifactoryLocalRepository(),
+		"artifactory_local_nuget_repository":          resourceArtifactoryLocalNugetRepository(),
+		"artifactory_local_maven_repository":          resourceArtifactoryLocalJavaRepository("maven", false),
+		"artifactory_local_gradle_repository":         resourceArtifactoryLocalJavaRepository("gradle", true),
+		"artifactory_local_sbt_repository":            resourceArtifactoryLocalJavaRepository("sbt", false),
+		"artifactory_local_ivy_repository":            resourceArtifactoryLocalJavaRepository("ivy", false),
+		"artifactory_local_alpine_repository":         resourceArtifactoryLocalAlpineRepository(),
+		"artifactory_local_debian_repository":         resourceArtifactoryLocalDebianRepository(),
+		"artifactory_local_docker_v2_repository":      resourceArtifactoryLocalDockerV2Repository(),
+		"artifactory_local_docker_v1_repository":      resourceArtifactoryLocalDockerV1Repository(),
+		"artifactory_local_rpm_repository":            resourceArtifactoryLocalRpmRepository(),
+		"artifactory_remote_repository":               resourceArtifactoryRemoteRepository(),
+		"artifactory_remote_generic_repository":       resourceArtifactoryRemoteGenericRepository(),
+		"artifactory_remote_npm_repository":           resourceArtifactoryRemoteNpmRepository(),
+		"artifactory_remote_docker_repository":        resourceArtifactoryRemoteDockerRepository(),
+		"artifactory_remote_helm_repository":          resourceArtifactoryRemoteHelmRepository(),
+		"artifactory_remote_helm_oci_repository":      resourceArtifactoryRemoteHelmOciRepository(),
+		"artifactory_remote_bower_repository":         resourceArtifactoryRemoteBowerRepository(),
+		"artifactory_remote_cargo_repository":         resourceArtifactoryRemoteCargoRepository(),
+		"artifactory_remote_cocoapods_repository":     resourceArtifactoryRemoteCocoapodsRepository(),
+		"artifactory_remote_conan_repository":         resourceArtifactoryRemoteConanRepository(),
+		"artifactory_remote_huggingfaceml_repository": resourceArtifactoryRemoteHuggingFaceMlRepository(),
+		"artifactory_remote_pub_repository":           resourceArtifactoryRemotePubRepository(),
+		"artifactory_remote_gitlfs_repository":        resourceArtifactoryRemoteGitLfsRepository(),
+		"artifactory_remote_rpm_repository":           resourceArtifactoryRemoteRpmRepository(),
+		"artifactory_remote_vagrant_repository":       resourceArtifactoryRemoteVagrantRepository(),
+		"artifactory_remote_p2_repository":            resourceArtifactoryRemoteP2Repository(),
+		"artifactory_remote_opkg_repository":          resourceArtifactoryRemoteOpkgRepository(),
+		"artifactory_remote_debian_repository":        resourceArtifactoryRemoteDebianRepository(),
+		"artifactory_remote_conda_repository":         resourceArtifactoryRemoteCondaRepository(),
+		"artifactory_remote_swift_repository":         resourceArtifactoryRemoteSwiftRepository(),
+		"artifactory_remote_go_repository":            resourceArtifactoryRemoteGoRepository(),
+		"artifactory_remote_nuget_repository":         resourceArtifactoryRemoteNugetRepository(),
+		"artifactory_remote_cran_repository":          resourceArtifactoryRemoteCranRepository(),
+		"artifactory_remote_pypi_repository":          resourceArtifactoryRemotePypiRepository(),
+		"artifactory_remote_maven_repository":         resourceArtifactoryRemoteJavaRepository("maven", false),
+		"artifactory_remote_gradle_repository":        resourceArtifactoryRemoteJavaRepository("gradle", true),
+		"artifactory_remote_sbt_repository":           resourceArtifactoryRemoteJavaRepository("sbt", false),
+		"artifactory_remote_ivy_repository":           resourceArtifactoryRemoteJavaRepository("ivy", false),
+		"artifactory_remote_vcs_repository":           resourceArtifactoryRemoteVcsRepository(),
+		"artifactory_virtual_repository":              resourceArtifactoryVirtualRepository(),
+		"artifactory_virtual_maven_repository":        resourceArtifactoryMavenVirtualRepository(),
+		"artifactory_virtual_go_repository":           resourceArtifactoryGoVirtualRepository(),
+		"artifactory_virtual_conan_repository":        resourceArtifactoryConanVirtualRepository(),
+		"artifactory_virtual_rpm_repository":          resourceArtifactoryRpmVirtualRepository(),
+		"artifactory_virtual_debian_repository":       resourceArtifactoryDebianVirtualRepository(),
+		"artifactory_virtual_conda_repository":        resourceArtifactoryCondaVirtualRepository(),
+		"artifactory_virtual_p2_repository":           resourceArtifactoryP2VirtualRepository(),
+		"artifactory_virtual_alpine_repository":       resourceArtifactoryAlpineVirtualRepository(),
+		"artifactory_virtual_cargo_repository":        resourceArtifactoryCargoVirtualRepository(),
+		"artifactory_virtual_bower_repository":        resourceArtifactoryBowerVirtualRepository(),
+		"artifactory_virtual_generic_repository":      resourceArtifactoryVirtualGenericRepository("generic"),
+		"artifactory_virtual_helm_repository":         resourceArtifactoryHelmVirtualRepository(),
+		"artifactory_virtual_nuget_repository":        resourceArtifactoryNugetVirtualRepository(),
+		"artifactory_virtual_pypi_repository":         resourceArtifactoryPypiVirtualRepository(),
+		"artifactory_virtual_sbt_repository":          resourceArtifactoryJavaVirtualRepository("sbt"),
+		"artifactory_virtual_ivy_repository":          resourceArtifactoryJavaVirtualRepository("ivy"),
+		"artifactory_virtual_terraform_repository":    resourceArtifactoryTerraformVirtualRepository(),
+		"artifactory_virtual_swift_repository":        resourceArtifactorySwiftVirtualRepository(),
+		"artifactory_virtual_gitlfs_repository":       resourceArtifactoryGitLfsVirtualRepository(),
+		"artifactory_virtual_chef_repository":         resourceArtifactoryChefVirtualRepository(),
+		"artifactory_group":                           resourceArtifactoryGroup(),
+		"artifactory_group_members":                   resourceArtifactoryGroupMembers(),
+		"artifactory_user":                            resourceArtifactoryUser(),
+		"artifactory_permission_target":               resourceArtifactoryPermissionTarget(),
+		"artifactory_pull_replication":                resourceArtifactoryPullReplication(),
+		"artifactory_push_replication":                resourceArtifactoryPushReplication(),
+		"artifactory_certificate":                     resourceArtifactoryCertificate(),
+		"artifactory_api_key":                         resourceArtifactoryApiKey(),
+		"artifactory_access_token":                    resourceArtifactoryAccessToken(),
+		"artifactory_general_security":                resourceArtifactoryGeneralSecurity(),
+		"artifactory_oauth_settings":                  resourceArtifactoryOauthSettings(),
+		"artifactory_saml_settings":                   resourceArtifactorySamlSettings(),
 		// Deprecated. Remove in V3
 		"artifactory_permission_targets":        resourceArtifactoryPermissionTargets(),
 		"artifactory_replication_config":        resourceArtifactoryReplicationConfig(),
@@ -61,6 +105,7 @@ func Provider() *schema.Provider {
 		"artifactory_ldap_setting":              resourceArtifactoryLdapSetting(),
 		"artifactory_ldap_group_setting":        resourceArtifactoryLdapGroupSetting(),
 		"artifactory_backup":                    resourceArtifactoryBackup(),
+		"artifactory_retention_policy":          resourceArtifactoryRetentionPolicy(),
 		// Xray resources. Deprecated, moved to a separate provider
 		"artifactory_xray_policy": resourceXrayPolicy(),
 		"artifactory_xray_watch":  resourceXrayWatch(),
@@ -73,6 +118,9 @@ func Provider() *schema.Provider {
 		federatedResourceName := fmt.Sprintf("artifactory_federated_%s_repository", repoType)
 		resoucesMap[federatedResourceName] = resourceArtifactoryFederatedGenericRepository(repoType)
 	}
+	// federated repository types with attributes of their own
+	resoucesMap["artifactory_federated_docker_repository"] = resourceArtifactoryFederatedDockerRepository()
+	resoucesMap["artifactory_federated_rpm_repository"] = resourceArtifactoryFederatedRpmRepository()
 
 	for _, webhookType := range webhookTypesSupported {
 		webhookResourceName := fmt.Sprintf("artifactory_%s_webhook", webhookType)
@@ -128,22 +176,40 @@ func Provider() *schema.Provider {
 				Default:     true,
 				Description: "Toggle for pre-flight checking of Artifactory Pro and Enterprise license. Default to `true`.",
 			},
+			"extra_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional HTTP headers sent with every request, e.g. the token required by a gateway in front of Artifactory.",
+			},
+			"http_debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARTIFACTORY_HTTP_DEBUG", false),
+				Description: "Log every request sent to Artifactory and its response at the DEBUG level of TF_LOG. The credential headers and the body fields holding passwords, tokens, keys and secrets are redacted. Default to `false`.",
+			},
 		},
 
 		ResourcesMap: resoucesMap,
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"artifactory_file":     dataSourceArtifactoryFile(),
-			"artifactory_fileinfo": dataSourceArtifactoryFileInfo(),
+			"artifactory_file":             dataSourceArtifactoryFile(),
+			"artifactory_fileinfo":         dataSourceArtifactoryFileInfo(),
+			"artifactory_replication":      dataSourceArtifactoryReplication(),
+			"artifactory_backup":           dataSourceArtifactoryBackup(),
+			"artifactory_webhook":          dataSourceArtifactoryWebhook(),
+			"artifactory_access_token":     dataSourceArtifactoryAccessToken(),
+			"artifactory_repository_stats": dataSourceArtifactoryRepositoryStats(),
 		},
 	}
 
-	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+		return providerConfigure(ctx, d, terraformVersion)
 	}
 
 	return p
@@ -156,7 +222,9 @@ func buildResty(URL string) (*resty.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	baseUrl := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	// the endpoints all start with artifactory/, so the host url keeps only the base path in front of it, if any
+	basePath := strings.TrimSuffix(strings.TrimRight(u.Path, "/"), "/artifactory")
+	baseUrl := fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, basePath)
 	restyBase := resty.New().SetHostURL(baseUrl).OnAfterResponse(func(client *resty.Client, response *resty.Response) error {
 		if response == nil {
 			return fmt.Errorf("no response found")
@@ -189,16 +257,91 @@ func addAuthToResty(client *resty.Client, username, password, apiKey, accessToke
 	return nil, fmt.Errorf("no authentication details supplied")
 }
 
+func addExtraHeadersToResty(client *resty.Client, extraHeaders map[string]interface{}) *resty.Client {
+	headers := map[string]string{}
+	for name, value := range extraHeaders {
+		headers[name] = value.(string)
+	}
+	return client.SetHeaders(headers)
+}
+
+// userAgent identifies the provider and the Terraform version driving it in the requests, which JFrog support can
+// filter their logs on
+func userAgent(terraformVersion string) string {
+	return fmt.Sprintf("jfrog/terraform-provider-artifactory:%s terraform/%s", Version, terraformVersion)
+}
+
+// restyLogger sends the resty logs to the standard logger, which the plugin SDK forwards to TF_LOG
+type restyLogger struct{}
+
+func (restyLogger) Errorf(format string, v ...interface{}) {
+	log.Printf("[ERROR] "+format, v...)
+}
+
+func (restyLogger) Warnf(format string, v ...interface{}) {
+	log.Printf("[WARN] "+format, v...)
+}
+
+func (restyLogger) Debugf(format string, v ...interface{}) {
+	log.Printf("[DEBUG] "+format, v...)
+}
+
+// sensitiveJsonFields and sensitiveFormFields match the value of the body fields carrying credentials, e.g. a user
+// password, the password of a remote repository or the token sent to be revoked
+var sensitiveJsonFields = regexp.MustCompile(`("[^"]*(?i:password|passphrase|secret|token|api_?key|private_?key)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+var sensitiveFormFields = regexp.MustCompile(`((?:^|&)[^=&]*(?i:password|passphrase|secret|token|api_?key|private_?key)[^=&]*=)[^&]*`)
+
+// addDebugToResty logs every request and response. The headers carrying credentials, the extra headers included,
+// and the body fields carrying credentials are redacted from the logged copies
+func addDebugToResty(client *resty.Client, extraHeaders map[string]interface{}) *resty.Client {
+	sensitiveHeaders := []string{"Authorization", "X-JFrog-Art-Api", "Set-Cookie"}
+	for name := range extraHeaders {
+		sensitiveHeaders = append(sensitiveHeaders, name)
+	}
+	redact := func(header http.Header, body string) string {
+		for _, name := range sensitiveHeaders {
+			if header.Get(name) != "" {
+				header.Set(name, "<redacted>")
+			}
+		}
+		if strings.HasPrefix(header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+			return sensitiveFormFields.ReplaceAllString(body, "${1}<redacted>")
+		}
+		return sensitiveJsonFields.ReplaceAllString(body, `$1"<redacted>"`)
+	}
+
+	return client.
+		SetLogger(restyLogger{}).
+		SetDebug(true).
+		OnRequestLog(func(requestLog *resty.RequestLog) error {
+			requestLog.Body = redact(requestLog.Header, requestLog.Body)
+			return nil
+		}).
+		OnResponseLog(func(responseLog *resty.ResponseLog) error {
+			responseLog.Body = redact(responseLog.Header, responseLog.Body)
+			return nil
+		})
+}
+
 // Creates the client for artifactory, will prefer token auth over basic auth if both set
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func providerConfigure(_ context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	URL, ok := d.GetOk("url")
 	if URL == nil || URL == "" || !ok {
-		return nil, fmt.Errorf("you must supply a URL")
+		return nil, diag.Errorf("you must supply a URL")
+	}
+	artifactoryUrl, err := normalizeArtifactoryUrl(URL.(string))
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid url %q", URL),
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("url"),
+		}}
 	}
 
-	restyBase, err := buildResty(URL.(string))
+	restyBase, err := buildResty(artifactoryUrl)
 	if err != nil {
-		return nil, err
+		return nil, diag.Errorf("invalid url %q: %s", artifactoryUrl, err)
 	}
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
@@ -207,27 +350,125 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 
 	restyBase, err = addAuthToResty(restyBase, username, password, apiKey, accessToken)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
+	}
+	extraHeaders := d.Get("extra_headers").(map[string]interface{})
+	restyBase = addExtraHeadersToResty(restyBase, extraHeaders).SetHeader("user-agent", userAgent(terraformVersion))
+	if d.Get("http_debug").(bool) {
+		restyBase = addDebugToResty(restyBase, extraHeaders)
+	}
+
+	err = checkArtifactoryPing(restyBase)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to reach Artifactory at %s", artifactoryUrl),
+			Detail: fmt.Sprintf("Check that `url` points to your Artifactory instance (e.g. https://myinstance.jfrog.io/artifactory) "+
+				"and that it is reachable from where terraform runs. %s", err),
+			AttributePath: cty.GetAttrPath("url"),
+		}}
 	}
 
 	checkLicense := d.Get("check_license").(bool)
 	if checkLicense {
 		err = checkArtifactoryLicense(restyBase)
 		if err != nil {
-			return nil, err
+			return nil, diag.FromErr(err)
 		}
 	}
 
 	_, err = sendUsageRepo(restyBase, terraformVersion)
 
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 
 	return restyBase, nil
 
 }
 
+// normalizeArtifactoryUrl returns the url of the instance as <scheme>://<host>[/<base path>]/artifactory. A base path,
+// e.g. behind a reverse proxy, is kept. The requests are always sent to the artifactory context under it, so a url with
+// a path that doesn't end with /artifactory would be silently ignored and is rejected instead
+func normalizeArtifactoryUrl(URL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(URL))
+	if err != nil {
+		return "", err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("the url must be an absolute http(s) url, e.g. https://myinstance.jfrog.io/artifactory")
+	}
+	path := strings.TrimRight(u.Path, "/")
+	if path != "" && !strings.HasSuffix(path, "/artifactory") {
+		return "", fmt.Errorf("the url path must end with /artifactory, e.g. %s://%s/artifactory, as the requests are always sent to it", u.Scheme, u.Host)
+	}
+
+	return fmt.Sprintf("%s://%s%s/artifactory", u.Scheme, u.Host, strings.TrimSuffix(path, "/artifactory")), nil
+}
+
+// checkArtifactoryPing makes sure the instance answers on the system ping endpoint before anything else talks to it
+func checkArtifactoryPing(client *resty.Client) error {
+	resp, err := client.R().Get("artifactory/api/system/ping")
+	if err != nil {
+		return err
+	}
+	if body := strings.TrimSpace(resp.String()); body != "OK" {
+		return fmt.Errorf("unexpected response from %s: %s", resp.Request.URL, body)
+	}
+
+	return nil
+}
+
+// getArtifactoryVersion returns the version of the instance, e.g. "7.63.5"
+func getArtifactoryVersion(client *resty.Client) (string, error) {
+	type Version struct {
+		Version string `json:"version"`
+	}
+
+	version := Version{}
+	_, err := client.R().SetResult(&version).Get("artifactory/api/system/version")
+	return version.Version, err
+}
+
+// isNewerVersion reports whether version is strictly newer than baseline. Only the numeric dotted
+// components are compared, anything after them (e.g. "-SNAPSHOT") is ignored
+func isNewerVersion(version, baseline string) bool {
+	parse := func(v string) []int {
+		var parts []int
+		for _, part := range strings.Split(v, ".") {
+			n := 0
+			digits := 0
+			for _, c := range part {
+				if c < '0' || c > '9' {
+					break
+				}
+				n = n*10 + int(c-'0')
+				digits++
+			}
+			if digits == 0 {
+				break
+			}
+			parts = append(parts, n)
+		}
+		return parts
+	}
+
+	v, b := parse(version), parse(baseline)
+	for i := 0; i < len(v) || i < len(b); i++ {
+		var vp, bp int
+		if i < len(v) {
+			vp = v[i]
+		}
+		if i < len(b) {
+			bp = b[i]
+		}
+		if vp != bp {
+			return vp > bp
+		}
+	}
+	return false
+}
+
 func checkArtifactoryLicense(client *resty.Client) error {
 
 	type License struct {
@@ -1,9 +1,14 @@
 package artifactory
 
 import (
+	"bytes"
 	"context"
 	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
@@ -31,6 +36,173 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestNormalizeArtifactoryUrl(t *testing.T) {
+	for URL, expected := range map[string]string{
+		"https://myinstance.jfrog.io":               "https://myinstance.jfrog.io/artifactory",
+		"https://myinstance.jfrog.io/":              "https://myinstance.jfrog.io/artifactory",
+		"https://myinstance.jfrog.io/artifactory":   "https://myinstance.jfrog.io/artifactory",
+		"http://localhost:8081/artifactory/":        "http://localhost:8081/artifactory",
+		" https://myinstance.jfrog.io/artifactory ": "https://myinstance.jfrog.io/artifactory",
+		"https://example.com/jfrog/artifactory":     "https://example.com/jfrog/artifactory",
+		"https://example.com/a/b/artifactory/":      "https://example.com/a/b/artifactory",
+	} {
+		normalized, err := normalizeArtifactoryUrl(URL)
+		if err != nil {
+			t.Errorf("expected %q to be accepted, got %s", URL, err)
+		} else if normalized != expected {
+			t.Errorf("expected %q to be normalized to %s, got %s", URL, expected, normalized)
+		}
+	}
+
+	for _, URL := range []string{
+		"myinstance.jfrog.io/artifactory",
+		"ftp://myinstance.jfrog.io/artifactory",
+		"https://myinstance.jfrog.io/ui",
+		"https://myinstance.jfrog.io/artifactory/api",
+		"https://example.com/jfrog",
+		"https://example.com/myartifactory",
+	} {
+		if _, err := normalizeArtifactoryUrl(URL); err == nil {
+			t.Errorf("expected %q to be rejected", URL)
+		}
+	}
+}
+
+func TestCheckArtifactoryPing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/system/ping" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkArtifactoryPing(client.SetRetryCount(0)); err != nil {
+		t.Fatalf("expected ping to succeed, got: %s", err)
+	}
+
+	server.Config.Handler = http.NotFoundHandler()
+	if err := checkArtifactoryPing(client); err == nil {
+		t.Fatal("expected ping to fail on a 404")
+	}
+}
+
+func TestBuildRestyKeepsBasePath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jfrog/artifactory/api/system/ping" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/jfrog/artifactory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkArtifactoryPing(client.SetRetryCount(0)); err != nil {
+		t.Fatalf("expected ping under the base path to succeed, got: %s", err)
+	}
+}
+
+func TestAddExtraHeadersToResty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway-Token") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = addExtraHeadersToResty(client.SetRetryCount(0), map[string]interface{}{"X-Gateway-Token": "secret"})
+	if err := checkArtifactoryPing(client); err != nil {
+		t.Fatalf("expected the extra header to be sent, got: %s", err)
+	}
+}
+
+func TestAddDebugToResty(t *testing.T) {
+	var userAgentSent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgentSent = r.Header.Get("User-Agent")
+		if r.URL.Path == "/artifactory/api/security/apiKey" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiKey":"my-api-key"}`))
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = addAuthToResty(client.SetRetryCount(0), "", "", "", "my-access-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extraHeaders := map[string]interface{}{"X-Gateway-Token": "my-gateway-token"}
+	client = addExtraHeadersToResty(client, extraHeaders).SetHeader("user-agent", userAgent("1.0.0"))
+	client = addDebugToResty(client, extraHeaders)
+	if err := checkArtifactoryPing(client); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.R().SetBody(map[string]string{"name": "admin", "password": "my-user-password"}).Post("artifactory/api/security/apiKey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.R().SetFormData(map[string]string{"token": "my-revoked-token"}).Post("artifactory/api/security/token/revoke")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(userAgentSent, "terraform-provider-artifactory:"+Version) || !strings.Contains(userAgentSent, "terraform/1.0.0") {
+		t.Errorf("expected the user agent to carry the provider and terraform versions, got %q", userAgentSent)
+	}
+	if !strings.Contains(logs.String(), "artifactory/api/system/ping") {
+		t.Errorf("expected the request to be logged, got:\n%s", logs.String())
+	}
+	for _, secret := range []string{"my-access-token", "my-gateway-token", "my-user-password", "my-api-key", "my-revoked-token"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("expected %s to be redacted from the logs", secret)
+		}
+	}
+}
+
+func TestIsNewerVersion(t *testing.T) {
+	for _, tc := range []struct {
+		version  string
+		baseline string
+		newer    bool
+	}{
+		{"7.63.0", "7.63.0", false},
+		{"7.63.1", "7.63.0", true},
+		{"7.64", "7.63.0", true},
+		{"7.9.0", "7.63.0", false},
+		{"8.0.0-SNAPSHOT", "7.63.0", true},
+		{"", "7.63.0", false},
+	} {
+		if newer := isNewerVersion(tc.version, tc.baseline); newer != tc.newer {
+			t.Errorf("isNewerVersion(%q, %q) = %t, expected %t", tc.version, tc.baseline, newer, tc.newer)
+		}
+	}
+}
+
 func uploadTestFile(client *resty.Client, localPath, remotePath, contentType string) error {
 	body, err := ioutil.ReadFile(localPath)
 	if err != nil {
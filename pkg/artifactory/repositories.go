@@ -3,13 +3,17 @@ package artifactory
 import (
 	"context"
 	"fmt"
+	"html"
+	"log"
 	"net/http"
 	"reflect"
 	"regexp"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -84,7 +88,7 @@ type RemoteRepositoryBaseParams struct {
 	IncludesPattern          string   `hcl:"includes_pattern" json:"includesPattern,omitempty"`
 	ExcludesPattern          string   `hcl:"excludes_pattern" json:"excludesPattern,omitempty"`
 	RepoLayoutRef            string   `hcl:"repo_layout_ref" json:"repoLayoutRef,omitempty"`
-	RemoteRepoLayoutRef      string   `json:"remoteRepoLayoutRef"`
+	RemoteRepoLayoutRef      string   `hcl:"remote_repo_layout_ref" json:"remoteRepoLayoutRef,omitempty"`
 	HardFail                 *bool    `hcl:"hard_fail" json:"hardFail,omitempty"`
 	Offline                  *bool    `hcl:"offline" json:"offline,omitempty"`
 	BlackedOut               *bool    `hcl:"blacked_out" json:"blackedOut,omitempty"`
@@ -107,16 +111,22 @@ type RemoteRepositoryBaseParams struct {
 	PropertySets                      []string                `hcl:"property_sets" json:"propertySets,omitempty"`
 	AllowAnyHostAuth                  *bool                   `hcl:"allow_any_host_auth" json:"allowAnyHostAuth,omitempty"`
 	EnableCookieManagement            *bool                   `hcl:"enable_cookie_management" json:"enableCookieManagement,omitempty"`
-	BypassHeadRequests                *bool                   `hcl:"bypass_head_requests" json:"bypassHeadRequests,omitempty"`
+	BypassHeadRequests                bool                    `hcl:"bypass_head_requests" json:"bypassHeadRequests"`
 	ClientTlsCertificate              string                  `hcl:"client_tls_certificate" json:"clientTlsCertificate,omitempty"`
 	ContentSynchronisation            *ContentSynchronisation `hcl:"content_synchronisation" json:"contentSynchronisation,omitempty"`
 	ListRemoteFolderItems             bool                    `json:"listRemoteFolderItems"`
+	Curated                           bool                    `hcl:"curated" json:"curated"`
+	DownloadRedirect                  bool                    `hcl:"download_redirect" json:"downloadRedirect"`
 }
 
 func (bp RemoteRepositoryBaseParams) Id() string {
 	return bp.Key
 }
 
+func (bp RemoteRepositoryBaseParams) IsCurated() bool {
+	return bp.Curated
+}
+
 type VirtualRepositoryBaseParams struct {
 	Key                                           string   `hcl:"key" json:"key,omitempty"`
 	ProjectKey                                    string   `json:"projectKey"`
@@ -170,7 +180,7 @@ func mkRepoCreate(unpack UnpackFunc, read schema.ReadContextFunc) schema.CreateC
 		_, err = m.(*resty.Client).R().AddRetryCondition(retryOnMergeError).SetBody(repo).Put(repositoriesEndpoint + key)
 
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(curationError(repo, err))
 		}
 		d.SetId(key)
 		return read(ctx, d, m)
@@ -203,7 +213,7 @@ func mkRepoUpdate(unpack UnpackFunc, read schema.ReadContextFunc) schema.UpdateC
 		// repo must be a pointer
 		_, err = m.(*resty.Client).R().AddRetryCondition(retryOnMergeError).SetBody(repo).Post(repositoriesEndpoint + d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(curationError(repo, err))
 		}
 
 		d.SetId(key)
@@ -274,6 +284,7 @@ var repoTypesSupported = []string{
 	"pypi",
 	"rpm",
 	"sbt",
+	"swift",
 	"vagrant",
 	"vcs",
 }
@@ -320,17 +331,123 @@ var repoTypesLikeGeneric = []string{
 	"gitlfs",
 	"go",
 	"helm",
-	"ivy",
 	"npm",
 	"opkg",
 	"puppet",
 	"pypi",
-	"sbt",
 	"vagrant",
 }
 
 var projectEnvironmentsSupported = []string{"DEV", "PROD"}
 
+// defaultRepoLayoutRefs are the layouts a repository resource defaults to for package types that
+// don't get the right layout from Artifactory when repo_layout_ref is omitted
+var defaultRepoLayoutRefs = map[string]string{
+	"alpine":        "simple-default",
+	"bower":         "bower-default",
+	"cargo":         "simple-default",
+	"chef":          "simple-default",
+	"cocoapods":     "simple-default",
+	"conan":         "conan-default",
+	"conda":         "conda-default",
+	"cran":          "simple-default",
+	"debian":        "simple-default",
+	"gitlfs":        "simple-default",
+	"go":            "go-default",
+	"gradle":        "maven-2-default",
+	"helmoci":       "simple-default",
+	"huggingfaceml": "simple-default",
+	"ivy":           "ivy-default",
+	"maven":         "maven-2-default",
+	"nuget":         "nuget-default",
+	"opkg":          "simple-default",
+	"p2":            "simple-default",
+	"pub":           "simple-default",
+	"pypi":          "simple-default",
+	"rpm":           "simple-default",
+	"sbt":           "sbt-default",
+	"swift":         "simple-default",
+	"vagrant":       "simple-default",
+}
+
+// repoLayoutRefSchema documents the default layout for the package type on the repo_layout_ref of the base schemas. It
+// stays computed rather than getting a Default, so existing repositories with another layout keep it when the
+// attribute isn't configured. The default is only sent when the repository is created, see defaultRepoLayoutRef
+func repoLayoutRefSchema(rclass, packageType string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"repo_layout_ref": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("Repository layout key for the %s repository. Default value is '%s'.", rclass, defaultRepoLayoutRefs[packageType]),
+		},
+	}
+}
+
+// defaultRepoLayoutRef is the layout to send for a repository: the one configured, or the default layout of the package
+// type when a new repository is created without one. An existing repository keeps the layout it has
+func defaultRepoLayoutRef(s *schema.ResourceData, layout, packageType string) string {
+	if layout == "" && s.Id() == "" {
+		return defaultRepoLayoutRefs[packageType]
+	}
+	return layout
+}
+
+// maxUniqueSnapshotsSchema is shared by the repositories that clean up old snapshots, so the limit is declared and
+// validated the same way everywhere. A value of 0 means there is no limit
+var maxUniqueSnapshotsSchema = map[string]*schema.Schema{
+	"max_unique_snapshots": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          0,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description: "The maximum number of unique snapshots of a single artifact to store.\nOnce the number of " +
+			"snapshots exceeds this setting, older versions are removed.\nA value of 0 (default) indicates there is " +
+			"no limit, and unique snapshots are not cleaned up.",
+	},
+}
+
+// maxUniqueTagsSchema is the Docker counterpart of maxUniqueSnapshotsSchema
+var maxUniqueTagsSchema = map[string]*schema.Schema{
+	"max_unique_tags": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          0,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description: "The maximum number of unique tags of a single Docker image to store in this repository.\n" +
+			"Once the number tags for an image exceeds this setting, older tags are removed. A value of 0 (default) indicates there is no limit.\n" +
+			"This only applies to manifest v2",
+	},
+}
+
+// ForceAuth is the attribute forcing authentication on the repositories of a package type, e.g.
+// force_nuget_authentication, and the JSON field Artifactory carries it in, e.g. forceNugetAuthentication, which the
+// repository structs tag their field with. Virtual maven repositories, whose flag is computed, keep their own attribute
+type ForceAuth struct {
+	Schema    map[string]*schema.Schema
+	Attribute string
+	JsonField string
+}
+
+func mkForceAuth(packageType string) ForceAuth {
+	attribute := fmt.Sprintf("force_%s_authentication", packageType)
+	return ForceAuth{
+		Schema: map[string]*schema.Schema{
+			attribute: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force basic authentication credentials in order to use this repository. Default value is 'false'.",
+			},
+		},
+		Attribute: attribute,
+		JsonField: fmt.Sprintf("force%s%sAuthentication", strings.ToUpper(packageType[:1]), packageType[1:]),
+	}
+}
+
+var conanForceAuth = mkForceAuth("conan")
+var nugetForceAuth = mkForceAuth("nuget")
+
 var baseLocalRepoSchema = map[string]*schema.Schema{
 	"key": {
 		Type:         schema.TypeString,
@@ -502,10 +619,11 @@ var baseRemoteSchema = map[string]*schema.Schema{
 		Description: "Repository layout key for the remote repository",
 	},
 	"remote_repo_layout_ref": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Repository layout key for the remote layout mapping",
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		Description: "Repository layout key for the remote layout mapping. Paths requested in the repo_layout_ref layout are " +
+			"translated to this layout before they are sent to the remote, e.g. to proxy a server with a different path structure.",
 	},
 	"hard_fail": {
 		Type:        schema.TypeBool,
@@ -630,8 +748,8 @@ var baseRemoteSchema = map[string]*schema.Schema{
 	"bypass_head_requests": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		Computed:    true,
-		Description: "Before caching an artifact, Artifactory first sends a HEAD request to the remote resource. In some remote resources, HEAD requests are disallowed and therefore rejected, even though downloading the artifact is allowed. When checked, Artifactory will bypass the HEAD request and cache the artifact directly using a GET request.",
+		Default:     false,
+		Description: "Before caching an artifact, Artifactory first sends a HEAD request to the remote resource. In some remote resources, HEAD requests are disallowed and therefore rejected, even though downloading the artifact is allowed. When checked, Artifactory will bypass the HEAD request and cache the artifact directly using a GET request. Default value is 'false'.",
 	},
 	"priority_resolution": {
 		Type:        schema.TypeBool,
@@ -673,22 +791,48 @@ var baseRemoteSchema = map[string]*schema.Schema{
 					Type:        schema.TypeBool,
 					Optional:    true,
 					Default:     false,
-					Description: `(Optional) If set, Artifactory displays an indication on cached items if they have been deleted from the corresponding repository in the remote Artifactory instance. Default value is 'false'`,
+					Description: `(Optional) If set, Artifactory displays an indication on cached items if they have been deleted from the corresponding repository in the remote Artifactory instance. Only takes effect when 'enabled' is true. Default value is 'false'`,
 				},
 			},
 		},
 	},
+	// propagate_query_params is only meaningful for generic remote repositories, whose schema lifts the restriction
 	"propagate_query_params": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		// a warning rather than an error, since configurations setting it on other package types used to plan fine
+		ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+			if value.(bool) {
+				return diag.Diagnostics{{
+					Severity:      diag.Warning,
+					Summary:       "propagate_query_params only applies to generic remote repositories",
+					Detail:        "Artifactory ignores propagate_query_params on remote repositories of other package types.",
+					AttributePath: path,
+				}}
+			}
+			return nil
+		},
+		Description: "When set, if query params are included in the request to Artifactory, they will be passed on to the remote repository. " +
+			"Only generic remote repositories support it, setting it on other package types has no effect and produces a warning. Default value is 'false'.",
+	},
+	"list_remote_folder_items": {
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "When set, if query params are included in the request to Artifactory, they will be passed on to the remote repository.",
+		Description: `(Optional) Lists the items of remote folders in simple and list browsing. The remote content is cached according to the value of the 'Retrieval Cache Period'. Default value is 'false'.`,
 	},
-	"list_remote_folder_items": {
+	"curated": {
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: `(Optional) Lists the items of remote folders in simple and list browsing. The remote content is cached according to the value of the 'Retrieval Cache Period'. Default value is 'false'.`,
+		Description: `(Optional) Enable repository to be protected by the Curation service. Requires the JFrog Curation add-on. Default value is 'false'.`,
+	},
+	"download_redirect": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: `(Optional) When set, download requests to this repository are redirected to the cloud storage location of the cached binary, instead of being served by Artifactory. Requires a cloud filestore. Default value is 'false'.`,
 	},
 }
 
@@ -787,7 +931,7 @@ func unpackBaseRepo(rclassType string, s *schema.ResourceData, packageType strin
 		Notes:                  d.getString("notes", false),
 		IncludesPattern:        d.getString("includes_pattern", false),
 		ExcludesPattern:        d.getString("excludes_pattern", false),
-		RepoLayoutRef:          d.getString("repo_layout_ref", false),
+		RepoLayoutRef:          defaultRepoLayoutRef(s, d.getString("repo_layout_ref", false), packageType),
 		BlackedOut:             d.getBoolRef("blacked_out", false),
 		ArchiveBrowsingEnabled: d.getBoolRef("archive_browsing_enabled", false),
 		PropertySets:           d.getSet("property_sets"),
@@ -814,11 +958,12 @@ func unpackBaseRemoteRepo(s *schema.ResourceData, packageType string) RemoteRepo
 		Notes:                    d.getString("notes", true),
 		IncludesPattern:          d.getString("includes_pattern", true),
 		ExcludesPattern:          d.getString("excludes_pattern", true),
-		RepoLayoutRef:            d.getString("repo_layout_ref", true),
+		RepoLayoutRef:            defaultRepoLayoutRef(s, d.getString("repo_layout_ref", true), packageType),
+		RemoteRepoLayoutRef:      d.getString("remote_repo_layout_ref", false),
 		HardFail:                 d.getBoolRef("hard_fail", true),
 		Offline:                  d.getBoolRef("offline", true),
 		BlackedOut:               d.getBoolRef("blacked_out", true),
-		XrayIndex:                d.getBool("xray_index", true),
+		XrayIndex:                d.getBool("xray_index", false),
 		StoreArtifactsLocally:    d.getBoolRef("store_artifacts_locally", true),
 		SocketTimeoutMillis:      d.getInt("socket_timeout_millis", true),
 		LocalAddress:             d.getString("local_address", true),
@@ -835,10 +980,13 @@ func unpackBaseRemoteRepo(s *schema.ResourceData, packageType string) RemoteRepo
 		PropertySets:                      d.getSet("property_sets"),
 		AllowAnyHostAuth:                  d.getBoolRef("allow_any_host_auth", true),
 		EnableCookieManagement:            d.getBoolRef("enable_cookie_management", true),
-		BypassHeadRequests:                d.getBoolRef("bypass_head_requests", true),
+		BypassHeadRequests:                d.getBool("bypass_head_requests", false),
 		ClientTlsCertificate:              d.getString("client_tls_certificate", true),
 		PriorityResolution:                d.getBool("priority_resolution", false),
 		ListRemoteFolderItems:             d.getBool("list_remote_folder_items", false),
+		Curated:                           d.getBool("curated", false),
+		DownloadRedirect:                  d.getBool("download_redirect", false),
+		PropagateQueryParams:              d.getBool("propagate_query_params", false),
 	}
 
 	if v, ok := d.GetOk("content_synchronisation"); ok {
@@ -891,7 +1039,7 @@ func unpackBaseVirtRepo(s *schema.ResourceData, packageType string) VirtualRepos
 		PackageType:         packageType, // must be set independently
 		IncludesPattern:     d.getString("includes_pattern", false),
 		ExcludesPattern:     d.getString("excludes_pattern", false),
-		RepoLayoutRef:       d.getString("repo_layout_ref", false),
+		RepoLayoutRef:       defaultRepoLayoutRef(s, d.getString("repo_layout_ref", false), packageType),
 		ArtifactoryRequestsCanRetrieveRemoteArtifacts: d.getBool("artifactory_requests_can_retrieve_remote_artifacts", false),
 		Repositories:          d.getList("repositories"),
 		Description:           d.getString("description", false),
@@ -1107,6 +1255,9 @@ func inSchema(skeema map[string]*schema.Schema) func(payload interface{}, d *sch
 	return universalPack(schemaHasKey(skeema))
 }
 
+// htmlEscapedFields come back HTML escaped from Artifactory, e.g. 'a &amp; b', so they are unescaped to match the configuration
+var htmlEscapedFields = []string{"description", "notes"}
+
 // universalPack consider making this a function that takes a predicate of what to include and returns
 // a function that does the job. This would allow for the legacy code to specify which keys to keep and not
 func universalPack(predicate HclPredicate) func(payload interface{}, d *schema.ResourceData) error {
@@ -1118,6 +1269,12 @@ func universalPack(predicate HclPredicate) func(payload interface{}, d *schema.R
 
 		values := lookup(payload, predicate)
 
+		for _, hcl := range htmlEscapedFields {
+			if value, ok := values[hcl].(string); ok {
+				values[hcl] = html.UnescapeString(value)
+			}
+		}
+
 		for hcl, value := range values {
 			if predicate != nil && predicate(hcl) {
 				errors = setValue(hcl, value)
@@ -1131,6 +1288,53 @@ func universalPack(predicate HclPredicate) func(payload interface{}, d *schema.R
 	}
 }
 
+// RepoLayouts is the part of the system configuration listing the repository layouts, built-in and custom alike
+type RepoLayouts struct {
+	Layouts []struct {
+		Name string `xml:"name"`
+	} `xml:"repoLayouts>repoLayout"`
+}
+
+// repoLayoutRefDiff rejects a repo_layout_ref that doesn't exist, which Artifactory would otherwise only answer with a 400
+// on apply. It only runs when repo_layout_ref changes, and is best effort: reading the configuration needs an admin, so
+// a 403 (or any other failure) skips the check rather than failing the plan
+func repoLayoutRefDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange("repo_layout_ref") || !diff.NewValueKnown("repo_layout_ref") || m == nil {
+		return nil
+	}
+	layout := diff.Get("repo_layout_ref").(string)
+	if layout == "" {
+		return nil
+	}
+
+	repoLayouts := RepoLayouts{}
+	resp, err := m.(*resty.Client).R().
+		SetResult(&repoLayouts).
+		AddRetryCondition(neverRetry).
+		Get("artifactory/api/system/configuration")
+	if resp != nil && resp.StatusCode() == http.StatusForbidden {
+		log.Printf("[WARN] not allowed to read the repository layouts, skipping the repo_layout_ref check")
+		return nil
+	}
+	if err != nil {
+		log.Printf("[WARN] unable to read the repository layouts, skipping the repo_layout_ref check: %s", err)
+		return nil
+	}
+
+	var names []string
+	for _, repoLayout := range repoLayouts.Layouts {
+		if repoLayout.Name == layout {
+			return nil
+		}
+		names = append(names, repoLayout.Name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("repo_layout_ref %s does not exist, available layouts are: %s", layout, strings.Join(names, ", "))
+}
+
 func projectEnvironmentsDiff(_ context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	if data, ok := diff.GetOk("project_environments"); ok {
 		projectEnvironments := data.(*schema.Set).List()
@@ -1145,22 +1349,139 @@ func projectEnvironmentsDiff(_ context.Context, diff *schema.ResourceDiff, i int
 	return nil
 }
 
+// repositoryDetails is the part of a repository configuration needed to check the members of a virtual repository
+type repositoryDetails struct {
+	Rclass      string `json:"rclass"`
+	PackageType string `json:"packageType"`
+	// TerraformType is only returned for local Terraform repositories, 'module' or 'provider'
+	TerraformType string `json:"terraformType"`
+}
+
+func getRepositoryDetails(client *resty.Client, key string) (repositoryDetails, error) {
+	repo := repositoryDetails{}
+	_, err := client.R().SetResult(&repo).Get(repositoriesEndpoint + key)
+	return repo, err
+}
+
+// defaultDeploymentRepoWarning warns when a virtual repository aggregates a local repository but has no
+// default_deployment_repo set, since any deployment to the virtual repository will then be rejected. It's checked on
+// apply rather than plan, where the members created in the same apply exist and the warning can reach the user
+func defaultDeploymentRepoWarning(client *resty.Client, d *schema.ResourceData) diag.Diagnostics {
+	if _, ok := d.GetOk("default_deployment_repo"); ok {
+		return nil
+	}
+
+	for _, member := range castToStringArr(d.Get("repositories").([]interface{})) {
+		repo, err := getRepositoryDetails(client, member)
+		if err == nil && repo.Rclass == "local" {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("virtual repository %s has no default_deployment_repo", d.Get("key")),
+				Detail: fmt.Sprintf("repository %s contains local repository %s, but deployments to it will fail until "+
+					"default_deployment_repo is set. Consider setting default_deployment_repo = \"%s\"", d.Get("key"), member, member),
+				AttributePath: cty.GetAttrPath("default_deployment_repo"),
+			}}
+		}
+	}
+
+	return nil
+}
+
+// contentSynchronisationWarning warns about smart remote settings that Artifactory ignores while content synchronisation
+// is disabled. It looks at the configuration only, Artifactory doesn't always report content_synchronisation.enabled back
+func contentSynchronisationWarning(_ *resty.Client, d *schema.ResourceData) diag.Diagnostics {
+	if d.Get("content_synchronisation.0.source_origin_absence_detection").(bool) && !d.Get("content_synchronisation.0.enabled").(bool) {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("source_origin_absence_detection of %s has no effect", d.Get("key")),
+			Detail:        "content_synchronisation.source_origin_absence_detection only takes effect when content_synchronisation.enabled is true.",
+			AttributePath: cty.GetAttrPath("content_synchronisation").IndexInt(0).GetAttr("source_origin_absence_detection"),
+		}}
+	}
+
+	return nil
+}
+
+// mkRepositoriesPackageTypeDiff rejects virtual repository members of package types other than the ones given. Members
+// which can't be read, e.g. because they are created in the same apply, are left to Artifactory to validate
+func mkRepositoriesPackageTypeDiff(packageTypes ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		for _, member := range castToStringArr(diff.Get("repositories").([]interface{})) {
+			if member == "" {
+				continue
+			}
+			repo, err := getRepositoryDetails(m.(*resty.Client), member)
+			if err == nil && !contains(packageTypes, repo.PackageType) {
+				return fmt.Errorf("repository %s has package type %s, only %s repositories can be included", member, repo.PackageType, strings.Join(packageTypes, ", "))
+			}
+		}
+
+		return nil
+	}
+}
+
 func mkResourceSchema(skeema map[string]*schema.Schema, packer PackFunc, unpack UnpackFunc, constructor Constructor) *schema.Resource {
 	var reader = mkRepoRead(packer, constructor)
+	var customizeDiff schema.CustomizeDiffFunc = projectEnvironmentsDiff
+	if _, ok := skeema["repo_layout_ref"]; ok {
+		customizeDiff = customdiff.All(customizeDiff, repoLayoutRefDiff)
+	}
+	create := mkRepoCreate(unpack, reader)
+	update := mkRepoUpdate(unpack, reader)
+	if _, ok := skeema["default_deployment_repo"]; ok {
+		create = withApplyWarning(create, defaultDeploymentRepoWarning)
+		update = withApplyWarning(update, defaultDeploymentRepoWarning)
+	}
+	if tokenAuthentication, ok := skeema["enable_token_authentication"]; ok && tokenAuthentication.Optional {
+		create = withApplyWarning(create, dockerTokenAuthenticationWarning)
+		update = withApplyWarning(update, dockerTokenAuthenticationWarning)
+	}
+	if contentSynchronisation, ok := skeema["content_synchronisation"]; ok {
+		if _, ok := contentSynchronisation.Elem.(*schema.Resource).Schema["source_origin_absence_detection"]; ok {
+			create = withApplyWarning(create, contentSynchronisationWarning)
+			update = withApplyWarning(update, contentSynchronisationWarning)
+		}
+	}
 	return &schema.Resource{
-		CreateContext: mkRepoCreate(unpack, reader),
+		CreateContext: create,
 		ReadContext:   reader,
-		UpdateContext: mkRepoUpdate(unpack, reader),
+		UpdateContext: update,
 		DeleteContext: deleteRepo,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema:        skeema,
-		CustomizeDiff: projectEnvironmentsDiff,
+		CustomizeDiff: customizeDiff,
+	}
+}
+
+// withApplyWarning adds the warning about the configuration to a successful create or update. CustomizeDiff can't
+// return warnings, so configurations Artifactory accepts but won't behave as expected are reported on apply instead.
+// The warning is worked out before the apply, while d still holds the configuration rather than what was read back
+func withApplyWarning(apply func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, warning func(*resty.Client, *schema.ResourceData) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		warnings := warning(m.(*resty.Client), d)
+		diags := apply(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, warnings...)
 	}
 }
 
 type Identifiable interface {
 	Id() string
 }
+
+type Curatable interface {
+	IsCurated() bool
+}
+
+// curationError points at the Curation add-on when a curated repository is rejected, since the server error alone doesn't say so
+func curationError(repo interface{}, err error) error {
+	if curatable, ok := repo.(Curatable); ok && curatable.IsCurated() {
+		return fmt.Errorf("failed to save curated repository, make sure the JFrog Curation add-on is available on this instance: %s", err)
+	}
+	return err
+}
@@ -70,9 +70,10 @@ func resourceArtifactoryAccessToken() *schema.Resource {
 				ForceNew: true,
 			},
 			"audience": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAudience,
 			},
 			"groups": {
 				Type:     schema.TypeList,
@@ -25,7 +25,7 @@ func TestAccAccessTokenAudienceBad(t *testing.T) {
 		resource "artifactory_access_token" "foobar" {
 			end_date_relative = "1s"
 			username = artifactory_user.existinguser.name
-			audience = "bad"
+			audience = "bogus"
 			refreshable = true
 		}
 	`
@@ -36,7 +36,7 @@ func TestAccAccessTokenAudienceBad(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      audienceBad,
-				ExpectError: regexp.MustCompile("audience can contain only service IDs of Artifactory servers"),
+				ExpectError: regexp.MustCompile(`audience entry "bogus" is malformed`),
 			},
 		},
 	})
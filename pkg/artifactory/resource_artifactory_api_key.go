@@ -1,7 +1,9 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/go-resty/resty/v2"
@@ -19,6 +21,7 @@ func resourceArtifactoryApiKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceApiKeyCreate,
 		Read:   resourceApiKeyRead,
+		Update: resourceApiKeyUpdate,
 		Delete: apiKeyRevoke,
 
 		Importer: &schema.ResourceImporter{
@@ -31,8 +34,24 @@ func resourceArtifactoryApiKey() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"regenerate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value. Changing it regenerates the API key, e.g. to rotate it on a schedule.",
+			},
 		},
+
+		CustomizeDiff: regenerateApiKeyDiff,
+	}
+}
+
+// regenerateApiKeyDiff marks api_key as unknown when it is about to be regenerated, so resources using it are planned
+// with the new key rather than the stale one
+func regenerateApiKeyDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && d.HasChange("regenerate") {
+		return d.SetNewComputed("api_key")
 	}
+	return nil
 }
 
 func packApiKey(apiKey string, d *schema.ResourceData) error {
@@ -49,11 +68,18 @@ func packApiKey(apiKey string, d *schema.ResourceData) error {
 }
 
 func resourceApiKeyCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*resty.Client)
 	data := make(map[string]string)
 
-	_, err := m.(*resty.Client).R().SetResult(&data).Post(apiKeyEndpoint)
+	resp, err := client.R().SetResult(&data).AddRetryCondition(neverRetry).Post(apiKeyEndpoint)
 	if err != nil {
-		return err
+		if resp == nil || resp.StatusCode() != http.StatusBadRequest {
+			return err
+		}
+		// Artifactory refuses to create a second key for the user, so the existing one is taken over
+		if _, err := client.R().SetResult(&data).Get(apiKeyEndpoint); err != nil {
+			return err
+		}
 	}
 
 	if apiKey, ok := data["apiKey"]; ok {
@@ -77,6 +103,20 @@ func resourceApiKeyRead(d *schema.ResourceData, m interface{}) error {
 	return packApiKey(key, d)
 }
 
+func resourceApiKeyUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("regenerate") {
+		data := make(map[string]string)
+		_, err := m.(*resty.Client).R().SetResult(&data).Put(apiKeyEndpoint)
+		if err != nil {
+			return err
+		}
+		if apiKey, ok := data["apiKey"]; ok {
+			d.SetId(strconv.Itoa(schema.HashString(apiKey)))
+		}
+	}
+	return resourceApiKeyRead(d, m)
+}
+
 func apiKeyRevoke(_ *schema.ResourceData, m interface{}) error {
 	_, err := m.(*resty.Client).R().Delete(apiKeyEndpoint)
 	return err
@@ -1,6 +1,7 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -30,6 +31,74 @@ func TestAccApiKey(t *testing.T) {
 	})
 }
 
+func TestAccApiKeyRegenerate(t *testing.T) {
+	const template = `
+		resource "artifactory_api_key" "foobar" {
+			regenerate = "%s"
+		}
+	`
+	var firstKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckApiKeyDestroy("artifactory_api_key.foobar"),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, "1"),
+				Check: func(s *terraform.State) error {
+					firstKey = s.RootModule().Resources["artifactory_api_key.foobar"].Primary.Attributes["api_key"]
+					if firstKey == "" {
+						return fmt.Errorf("expected an api key to be created")
+					}
+					return nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(template, "2"),
+				Check: func(s *terraform.State) error {
+					if s.RootModule().Resources["artifactory_api_key.foobar"].Primary.Attributes["api_key"] == firstKey {
+						return fmt.Errorf("expected the api key to be regenerated")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestApiKeyRegeneratePlansNewKey(t *testing.T) {
+	apiKey := resourceArtifactoryApiKey()
+	state := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"id":         "1234",
+			"api_key":    "AKCp-stale",
+			"regenerate": "1",
+		},
+	}
+
+	diff, err := apiKey.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"regenerate": "2",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attribute, ok := diff.Attributes["api_key"]; !ok || !attribute.NewComputed {
+		t.Errorf("expected api_key to be unknown until it is regenerated, got %v", diff.Attributes["api_key"])
+	}
+
+	diff, err = apiKey.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"regenerate": "1",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no change without regenerate changing, got %v", diff)
+	}
+}
+
 func testAccCheckApiKeyDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		provider, _ := testAccProviders["artifactory"]()
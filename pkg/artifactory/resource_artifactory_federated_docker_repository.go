@@ -0,0 +1,144 @@
+package artifactory
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const federationStatusEndpoint = "artifactory/api/federation/status/repo/"
+
+var memberStatusSchema = map[string]*schema.Schema{
+	"member_status": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The synchronisation status of the other federated members, as reported by the federation status endpoint.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Base URL of the Artifactory hosting the member.",
+				},
+				"repo_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Key of the member repository.",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Health of the mirroring to the member, e.g. 'HEALTHY'.",
+				},
+				"lag_in_ms": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "How far behind the member is, in milliseconds.",
+				},
+			},
+		},
+	},
+}
+
+var dockerFederatedSchema = mergeSchema(dockerV2LocalSchema, federatedMemberSchema, memberStatusSchema)
+
+type FederationStatus struct {
+	MirrorEventsStatusInfo []struct {
+		RemoteUrl     string `json:"remoteUrl"`
+		RemoteRepoKey string `json:"remoteRepoKey"`
+		Status        string `json:"status"`
+		LagInMS       int    `json:"lagInMS"`
+	} `json:"mirrorEventsStatusInfo"`
+}
+
+type DockerFederatedRepositoryParams struct {
+	DockerLocalRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+}
+
+func resourceArtifactoryFederatedDockerRepository() *schema.Resource {
+	packer := composePacker(
+		universalPack(
+			allHclPredicate(
+				noClass, schemaHasKey(dockerV2LocalSchema),
+			),
+		),
+		func(repo interface{}, d *schema.ResourceData) error {
+			return packMembers(repo.(*DockerFederatedRepositoryParams).Members, d)
+		},
+	)
+
+	resource := mkResourceSchema(dockerFederatedSchema, packer, unpackFederatedDockerRepository, func() interface{} {
+		return &DockerFederatedRepositoryParams{
+			DockerLocalRepositoryParams: DockerLocalRepositoryParams{
+				LocalRepositoryBaseParams: LocalRepositoryBaseParams{
+					PackageType: "docker",
+					Rclass:      "federated",
+				},
+				DockerApiVersion:    "V2",
+				TagRetention:        1,
+				MaxUniqueTags:       0, // no limit
+				BlockPushingSchema1: true,
+			},
+		}
+	})
+	resource.CreateContext = withFederationStatus(resource.CreateContext)
+	resource.ReadContext = withFederationStatus(resource.ReadContext)
+	resource.UpdateContext = withFederationStatus(resource.UpdateContext)
+
+	return resource
+}
+
+// withFederationStatus fills in member_status once the repository itself has been read. The status is informational only,
+// so failing to fetch it, e.g. on versions without the endpoint, is logged rather than failing the operation
+func withFederationStatus(next func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := next(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		status := FederationStatus{}
+		_, err := m.(*resty.Client).R().SetResult(&status).AddRetryCondition(neverRetry).Get(federationStatusEndpoint + d.Id())
+		if err != nil {
+			log.Printf("[WARN] unable to get the federation status of %s: %s", d.Id(), err)
+			return diags
+		}
+
+		var memberStatus []interface{}
+		for _, mirror := range status.MirrorEventsStatusInfo {
+			memberStatus = append(memberStatus, map[string]interface{}{
+				"url":       mirror.RemoteUrl,
+				"repo_key":  mirror.RemoteRepoKey,
+				"status":    mirror.Status,
+				"lag_in_ms": mirror.LagInMS,
+			})
+		}
+
+		errors := mkLens(d)("member_status", memberStatus)
+		if errors != nil && len(errors) > 0 {
+			return append(diags, diag.Errorf("failed saving member_status to state %q", errors)...)
+		}
+		return diags
+	}
+}
+
+func unpackFederatedDockerRepository(data *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{ResourceData: data}
+	repo := DockerFederatedRepositoryParams{
+		DockerLocalRepositoryParams: DockerLocalRepositoryParams{
+			LocalRepositoryBaseParams: unpackBaseRepo("federated", data, "docker"),
+			MaxUniqueTags:             d.getInt("max_unique_tags", false),
+			DockerApiVersion:          "V2",
+			TagRetention:              d.getInt("tag_retention", false),
+			BlockPushingSchema1:       d.getBool("block_pushing_schema1", false),
+			EnableTokenAuthentication: d.getBoolRef("enable_token_authentication", false),
+		},
+		Members: unpackMembers(data),
+	}
+
+	return repo, repo.Id(), nil
+}
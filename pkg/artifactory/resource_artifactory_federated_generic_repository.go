@@ -2,110 +2,137 @@ package artifactory
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceArtifactoryFederatedGenericRepository(repoType string) *schema.Resource {
-	var federatedSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Schema{
-		"member": {
-			Type:     schema.TypeSet,
-			Required: true,
-			Description: "The list of Federated members. If a Federated member receives a request that does not include the repository URL, it will " +
-				"automatically be added with the combination of the configured base URL and `key` field value. " +
-				"Note that each of the federated members will need to have a base URL set. Please follow the [instruction](https://www.jfrog.com/confluence/display/JFROG/Working+with+Federated+Repositories#WorkingwithFederatedRepositories-SettingUpaFederatedRepository)" +
-				" to set up Federated repositories correctly.",
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"url": {
-						Type:             schema.TypeString,
-						Required:         true,
-						Description:      "Full URL to ending with the repositoryName",
-						ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
-					},
-					"enabled": {
-						Type:     schema.TypeBool,
-						Required: true,
-						Description: "Represents the active state of the federated member. It is supported to " +
-							"change the enabled status of my own member. The config will be updated on the other " +
-							"federated members automatically.",
-					},
+var federatedMemberSchema = map[string]*schema.Schema{
+	"member": {
+		Type:     schema.TypeSet,
+		Required: true,
+		Description: "The list of Federated members. If a Federated member receives a request that does not include the repository URL, it will " +
+			"automatically be added with the combination of the configured base URL and `key` field value. " +
+			"Note that each of the federated members will need to have a base URL set. Please follow the [instruction](https://www.jfrog.com/confluence/display/JFROG/Working+with+Federated+Repositories#WorkingwithFederatedRepositories-SettingUpaFederatedRepository)" +
+			" to set up Federated repositories correctly.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Full URL to ending with the repositoryName",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+					Description: "Represents the active state of the federated member. It is supported to " +
+						"change the enabled status of my own member. The config will be updated on the other " +
+						"federated members automatically.",
 				},
 			},
 		},
-	})
-
-	type Member struct {
-		Url     string `hcl:"url" json:"url"`
-		Enabled bool   `hcl:"enabled" json:"enabled"`
-	}
+	},
+}
 
-	type FederatedRepositoryParams struct {
-		LocalRepositoryBaseParams
-		Members []Member `hcl:"member" json:"members"`
-	}
+type Member struct {
+	Url     string `hcl:"url" json:"url"`
+	Enabled bool   `hcl:"enabled" json:"enabled"`
+}
 
-	var unpackMembers = func(data *schema.ResourceData) []Member {
-		d := &ResourceData{data}
+func unpackMembers(data *schema.ResourceData) []Member {
+	d := &ResourceData{data}
 
-		var members []Member
+	var members []Member
 
-		if v, ok := d.GetOkExists("member"); ok {
-			federatedMembers := v.(*schema.Set).List()
-			if len(federatedMembers) == 0 {
-				return members
-			}
+	if v, ok := d.GetOkExists("member"); ok {
+		federatedMembers := v.(*schema.Set).List()
+		if len(federatedMembers) == 0 {
+			return members
+		}
 
-			for _, federatedMember := range federatedMembers {
-				id := federatedMember.(map[string]interface{})
+		for _, federatedMember := range federatedMembers {
+			id := federatedMember.(map[string]interface{})
 
-				member := Member{
-					Url:     id["url"].(string),
-					Enabled: id["enabled"].(bool),
-				}
-				members = append(members, member)
+			member := Member{
+				Url:     id["url"].(string),
+				Enabled: id["enabled"].(bool),
 			}
+			members = append(members, member)
 		}
-		return members
 	}
+	sortMembers(members)
+	return members
+}
 
-	var unpackFederatedRepository = func(data *schema.ResourceData) (interface{}, string, error) {
-		repo := FederatedRepositoryParams{
-			LocalRepositoryBaseParams: unpackBaseRepo("federated", data, repoType),
-			Members:                   unpackMembers(data),
+// sortMembers orders the members by url, so the payload and the state don't depend on the order Artifactory or the set
+// hand them out in
+func sortMembers(members []Member) {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Url < members[j].Url
+	})
+}
+
+func packMembers(members []Member, d *schema.ResourceData) error {
+	setValue := mkLens(d)
+
+	var federatedMembers []interface{}
+
+	sortMembers(members)
+	for _, member := range members {
+		federatedMember := map[string]interface{}{
+			"url":     member.Url,
+			"enabled": member.Enabled,
 		}
 
-		return repo, repo.Id(), nil
+		federatedMembers = append(federatedMembers, federatedMember)
 	}
 
-	var packMembers = func(repo interface{}, d *schema.ResourceData) error {
-		setValue := mkLens(d)
+	errors := setValue("member", federatedMembers)
 
-		var federatedMembers []interface{}
+	if errors != nil && len(errors) > 0 {
+		return fmt.Errorf("failed saving members to state %q", errors)
+	}
 
-		members := repo.(*FederatedRepositoryParams).Members
-		for _, member := range members {
-			federatedMember := map[string]interface{}{
-				"url":     member.Url,
-				"enabled": member.Enabled,
-			}
+	return nil
+}
 
-			federatedMembers = append(federatedMembers, federatedMember)
-		}
+// federatedMemberStatusRepoTypes are the generic federated types which also expose member_status
+var federatedMemberStatusRepoTypes = map[string]bool{
+	"npm":  true,
+	"pypi": true,
+}
 
-		errors := setValue("member", federatedMembers)
+func resourceArtifactoryFederatedGenericRepository(repoType string) *schema.Resource {
+	var federatedSchema = mergeSchema(baseLocalRepoSchema, federatedMemberSchema)
+	// same default layout as the local pypi repository
+	if repoType == "pypi" {
+		federatedSchema = mergeSchema(federatedSchema, repoLayoutRefSchema("federated", repoType))
+	}
+	if federatedMemberStatusRepoTypes[repoType] {
+		federatedSchema = mergeSchema(federatedSchema, memberStatusSchema)
+	}
+
+	type FederatedRepositoryParams struct {
+		LocalRepositoryBaseParams
+		Members []Member `hcl:"member" json:"members"`
+	}
 
-		if errors != nil && len(errors) > 0 {
-			return fmt.Errorf("failed saving members to state %q", errors)
+	var unpackFederatedRepository = func(data *schema.ResourceData) (interface{}, string, error) {
+		repo := FederatedRepositoryParams{
+			LocalRepositoryBaseParams: unpackBaseRepo("federated", data, repoType),
+			Members:                   unpackMembers(data),
 		}
 
-		return nil
+		return repo, repo.Id(), nil
 	}
 
 	packer := composePacker(
 		universalPack(ignoreHclPredicate("class", "rclass", "member")),
-		packMembers,
+		func(repo interface{}, d *schema.ResourceData) error {
+			return packMembers(repo.(*FederatedRepositoryParams).Members, d)
+		},
 	)
 
 	constructor := func() interface{} {
@@ -117,5 +144,12 @@ func resourceArtifactoryFederatedGenericRepository(repoType string) *schema.Reso
 		}
 	}
 
-	return mkResourceSchema(federatedSchema, packer, unpackFederatedRepository, constructor)
+	resource := mkResourceSchema(federatedSchema, packer, unpackFederatedRepository, constructor)
+	if federatedMemberStatusRepoTypes[repoType] {
+		resource.CreateContext = withFederationStatus(resource.CreateContext)
+		resource.ReadContext = withFederationStatus(resource.ReadContext)
+		resource.UpdateContext = withFederationStatus(resource.UpdateContext)
+	}
+
+	return resource
 }
@@ -1,9 +1,11 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func skipFederatedRepo() (bool, string) {
@@ -75,6 +78,25 @@ func TestAccFederatedRepoWithMembers(t *testing.T) {
 	})
 }
 
+func TestSortMembers(t *testing.T) {
+	members := []Member{
+		{Url: "https://c.example.com/artifactory/repo", Enabled: true},
+		{Url: "https://a.example.com/artifactory/repo", Enabled: false},
+		{Url: "https://b.example.com/artifactory/repo", Enabled: true},
+	}
+	sortMembers(members)
+
+	for i, url := range []string{
+		"https://a.example.com/artifactory/repo",
+		"https://b.example.com/artifactory/repo",
+		"https://c.example.com/artifactory/repo",
+	} {
+		if members[i].Url != url {
+			t.Errorf("expected member %d to be %s, got %s", i, url, members[i].Url)
+		}
+	}
+}
+
 func federatedTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCase) {
 	if skip, reason := skipFederatedRepo(); skip {
 		t.Skipf(reason)
@@ -137,6 +159,218 @@ func TestAccFederatedRepoAllTypes(t *testing.T) {
 	}
 }
 
+func TestAccFederatedDockerRepository(t *testing.T) {
+	if skip, reason := skipFederatedRepo(); skip {
+		t.Skipf(reason)
+	}
+
+	_, fqrn, name := mkNames("terraform-federated-docker", "artifactory_federated_docker_repository")
+	federatedMemberUrl := fmt.Sprintf("%s/artifactory/%s", os.Getenv("ARTIFACTORY_URL"), name)
+
+	params := map[string]interface{}{
+		"name":      name,
+		"memberUrl": federatedMemberUrl,
+	}
+	federatedRepositoryConfig := executeTemplate("TestAccFederatedDockerRepository", `
+		resource "artifactory_federated_docker_repository" "{{ .name }}" {
+			key                   = "{{ .name }}"
+			max_unique_tags       = 5
+			tag_retention         = 2
+			block_pushing_schema1 = true
+
+			member {
+				url     = "{{ .memberUrl }}"
+				enabled = true
+			}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: federatedRepositoryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "package_type", "docker"),
+					resource.TestCheckResourceAttr(fqrn, "max_unique_tags", "5"),
+					resource.TestCheckResourceAttr(fqrn, "tag_retention", "2"),
+					resource.TestCheckResourceAttr(fqrn, "block_pushing_schema1", "true"),
+					resource.TestCheckResourceAttr(fqrn, "api_version", "V2"),
+					resource.TestCheckResourceAttr(fqrn, "member.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "member.0.url", federatedMemberUrl),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member_status"},
+			},
+		},
+	})
+}
+
+func TestAccFederatedRpmRepository(t *testing.T) {
+	if skip, reason := skipFederatedRepo(); skip {
+		t.Skipf(reason)
+	}
+
+	_, fqrn, name := mkNames("terraform-federated-rpm", "artifactory_federated_rpm_repository")
+	federatedMemberUrl := fmt.Sprintf("%s/artifactory/%s", os.Getenv("ARTIFACTORY_URL"), name)
+	otherMemberUrl := fmt.Sprintf("%s/artifactory/%s-2", os.Getenv("ARTIFACTORY_URL"), name)
+
+	params := map[string]interface{}{
+		"name":           name,
+		"memberUrl":      federatedMemberUrl,
+		"otherMemberUrl": otherMemberUrl,
+	}
+	federatedRepositoryConfig := executeTemplate("TestAccFederatedRpmRepository", `
+		resource "artifactory_federated_rpm_repository" "{{ .name }}" {
+			key                        = "{{ .name }}"
+			yum_root_depth             = 2
+			calculate_yum_metadata     = true
+			enable_file_lists_indexing = true
+			yum_group_file_names       = "file-2.xml,file-1.xml"
+
+			member {
+				url     = "{{ .otherMemberUrl }}"
+				enabled = true
+			}
+
+			member {
+				url     = "{{ .memberUrl }}"
+				enabled = true
+			}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: federatedRepositoryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "package_type", "rpm"),
+					resource.TestCheckResourceAttr(fqrn, "yum_root_depth", "2"),
+					resource.TestCheckResourceAttr(fqrn, "calculate_yum_metadata", "true"),
+					resource.TestCheckResourceAttr(fqrn, "enable_file_lists_indexing", "true"),
+					resource.TestCheckResourceAttr(fqrn, "yum_group_file_names", "file-1.xml,file-2.xml"),
+					resource.TestCheckResourceAttr(fqrn, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "member.*", map[string]string{"url": federatedMemberUrl, "enabled": "true"}),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "member.*", map[string]string{"url": otherMemberUrl, "enabled": "true"}),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFederatedPypiRepository(t *testing.T) {
+	if skip, reason := skipFederatedRepo(); skip {
+		t.Skipf(reason)
+	}
+
+	_, fqrn, name := mkNames("terraform-federated-pypi", "artifactory_federated_pypi_repository")
+	federatedMemberUrl := fmt.Sprintf("%s/artifactory/%s", os.Getenv("ARTIFACTORY_URL"), name)
+	otherMemberUrl := fmt.Sprintf("%s/artifactory/%s-2", os.Getenv("ARTIFACTORY_URL"), name)
+
+	params := map[string]interface{}{
+		"name":           name,
+		"memberUrl":      federatedMemberUrl,
+		"otherMemberUrl": otherMemberUrl,
+	}
+	federatedRepositoryConfig := executeTemplate("TestAccFederatedPypiRepository", `
+		resource "artifactory_federated_pypi_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+
+			member {
+				url     = "{{ .otherMemberUrl }}"
+				enabled = false
+			}
+
+			member {
+				url     = "{{ .memberUrl }}"
+				enabled = true
+			}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: federatedRepositoryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "package_type", "pypi"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "member.*", map[string]string{"url": federatedMemberUrl, "enabled": "true"}),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "member.*", map[string]string{"url": otherMemberUrl, "enabled": "false"}),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member_status"},
+			},
+		},
+	})
+}
+
+func TestFederatedRepositoryMemberStatus(t *testing.T) {
+	for _, repoType := range []string{"npm", "pypi"} {
+		t.Run(repoType, func(t *testing.T) {
+			key := repoType + "-federated"
+			client, repo := mkFakeRepositoryServer(t, key)
+			repo.responses["api/federation/status/repo/"+key] = map[string]interface{}{
+				"mirrorEventsStatusInfo": []map[string]interface{}{{
+					"remoteUrl":     "https://other.example.com/artifactory/",
+					"remoteRepoKey": key,
+					"status":        "HEALTHY",
+					"lagInMS":       42,
+				}},
+			}
+
+			federated := resourceArtifactoryFederatedGenericRepository(repoType)
+			d := schema.TestResourceDataRaw(t, federated.Schema, map[string]interface{}{
+				"key": key,
+				"member": []interface{}{map[string]interface{}{
+					"url":     "https://other.example.com/artifactory/" + key,
+					"enabled": true,
+				}},
+			})
+			if diags := federated.CreateContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("failed to create the repository: %v", diags)
+			}
+
+			expected := []interface{}{map[string]interface{}{
+				"url":       "https://other.example.com/artifactory/",
+				"repo_key":  key,
+				"status":    "HEALTHY",
+				"lag_in_ms": 42,
+			}}
+			if !reflect.DeepEqual(d.Get("member_status"), expected) {
+				t.Errorf("expected member_status %v, got %v", expected, d.Get("member_status"))
+			}
+		})
+	}
+
+	if _, ok := resourceArtifactoryFederatedGenericRepository("generic").Schema["member_status"]; ok {
+		t.Error("expected member_status only on the federated types reporting it")
+	}
+}
+
 func TestAccFederatedRepoWithProjectAttributesGH318(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	projectKey := fmt.Sprintf("t%d", randomInt())
@@ -0,0 +1,45 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var rpmFederatedSchema = mergeSchema(rpmLocalSchema, federatedMemberSchema)
+
+type RpmFederatedRepositoryParams struct {
+	RpmRepositoryParams
+	Members []Member `hcl:"member" json:"members"`
+}
+
+func resourceArtifactoryFederatedRpmRepository() *schema.Resource {
+	packer := composePacker(
+		universalPack(
+			allHclPredicate(
+				noClass, schemaHasKey(rpmLocalSchema),
+			),
+		),
+		func(repo interface{}, d *schema.ResourceData) error {
+			return packMembers(repo.(*RpmFederatedRepositoryParams).Members, d)
+		},
+	)
+
+	return mkResourceSchema(rpmFederatedSchema, packer, unpackFederatedRpmRepository, func() interface{} {
+		return &RpmFederatedRepositoryParams{
+			RpmRepositoryParams: RpmRepositoryParams{
+				LocalRepositoryBaseParams: LocalRepositoryBaseParams{
+					PackageType: "rpm",
+					Rclass:      "federated",
+				},
+			},
+		}
+	})
+}
+
+func unpackFederatedRpmRepository(data *schema.ResourceData) (interface{}, string, error) {
+	repo := RpmFederatedRepositoryParams{
+		RpmRepositoryParams: unpackRpmRepository(data, "federated"),
+		Members:             unpackMembers(data),
+	}
+
+	return repo, repo.Id(), nil
+}
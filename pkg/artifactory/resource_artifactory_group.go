@@ -3,6 +3,7 @@ package artifactory
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/go-resty/resty/v2"
 
@@ -13,6 +14,13 @@ import (
 
 const groupsEndpoint = "artifactory/api/security/groups/"
 
+const projectsEndpoint = "access/api/v1/projects/"
+
+var projectKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9]{1,31}$`)
+
+// defaultProjectRoles are given to the group in its projects when project_roles isn't set
+var defaultProjectRoles = []string{"Viewer"}
+
 func resourceArtifactoryGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGroupCreate,
@@ -83,6 +91,22 @@ func resourceArtifactoryGroup() *schema.Resource {
 				Default:     false,
 				Description: `(Optional) When this override is set,  User in the group can manage Xray Reports. Default value is 'false'.`,
 			},
+			"project_keys": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(projectKeyRegex, "project key must start with a lowercase letter and only contain lowercase letters and digits"),
+				},
+				Optional:    true,
+				Description: `(Optional) The projects the group is added to, through the Projects API. Requires an access token.`,
+			},
+			"project_roles": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: `(Optional) The project roles the members of the group get in each of the projects in project_keys. Default value is ["Viewer"].`,
+			},
 		},
 	}
 }
@@ -118,6 +142,47 @@ func groupParams(s *schema.ResourceData) (Group, bool, error) {
 	return group, includeUsers, nil
 }
 
+// ProjectGroup is the binding of a group to a project, along with the project roles of its members
+// https://www.jfrog.com/confluence/display/JFROG/Access+Projects+REST+API#AccessProjectsRESTAPI-UpdateGroupinProject
+type ProjectGroup struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+func projectGroupParams(s *schema.ResourceData) ([]string, []string) {
+	d := &ResourceData{s}
+
+	roles := d.getSet("project_roles")
+	if len(roles) == 0 {
+		roles = defaultProjectRoles
+	}
+	return d.getSet("project_keys"), roles
+}
+
+func projectGroupUrl(projectKey, groupName string) string {
+	return fmt.Sprintf("%s%s/groups/%s", projectsEndpoint, projectKey, groupName)
+}
+
+func addGroupToProjects(client *resty.Client, groupName string, projectKeys, roles []string) error {
+	for _, projectKey := range projectKeys {
+		_, err := client.R().SetBody(ProjectGroup{Name: groupName, Roles: roles}).Put(projectGroupUrl(projectKey, groupName))
+		if err != nil {
+			return fmt.Errorf("failed to add group %s to project %s: %s", groupName, projectKey, err)
+		}
+	}
+	return nil
+}
+
+func removeGroupFromProjects(client *resty.Client, groupName string, projectKeys []string) error {
+	for _, projectKey := range projectKeys {
+		resp, err := client.R().AddRetryCondition(neverRetry).Delete(projectGroupUrl(projectKey, groupName))
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return fmt.Errorf("failed to remove group %s from project %s: %s", groupName, projectKey, err)
+		}
+	}
+	return nil
+}
+
 func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
 	group, _, err := groupParams(d)
 	if err != nil {
@@ -130,7 +195,7 @@ func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(group.Name)
-	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		exists, err := resourceGroupExists(d, m)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("error describing group: %s", err))
@@ -142,6 +207,13 @@ func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// the group has to exist in Artifactory before the Projects API accepts it
+	projectKeys, roles := projectGroupParams(d)
+	return addGroupToProjects(m.(*resty.Client), group.Name, projectKeys, roles)
 }
 
 func resourceGroupGet(d *schema.ResourceData, m interface{}) (*Group, error) {
@@ -178,7 +250,24 @@ func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
 	setValue("watch_manager", group.WatchManager)
 	setValue("policy_manager", group.PolicyManager)
 	setValue("reports_manager", group.ReportsManager)
-	errors := setValue("users_names", schema.NewSet(schema.HashString, castToInterfaceArr(group.UsersNames)))
+	setValue("users_names", schema.NewSet(schema.HashString, castToInterfaceArr(group.UsersNames)))
+
+	// the security API knows nothing about projects, so only the bindings in state are checked
+	projectKeys, _ := projectGroupParams(d)
+	var boundProjectKeys []string
+	for _, projectKey := range projectKeys {
+		projectGroup := ProjectGroup{}
+		resp, err := m.(*resty.Client).R().SetResult(&projectGroup).AddRetryCondition(neverRetry).Get(projectGroupUrl(projectKey, d.Id()))
+		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+		boundProjectKeys = append(boundProjectKeys, projectKey)
+		setValue("project_roles", schema.NewSet(schema.HashString, castToInterfaceArr(projectGroup.Roles)))
+	}
+	errors := setValue("project_keys", schema.NewSet(schema.HashString, castToInterfaceArr(boundProjectKeys)))
 	if errors != nil && len(errors) > 0 {
 		return fmt.Errorf("failed saving state for groups %q", errors)
 	}
@@ -208,11 +297,34 @@ func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if d.HasChanges("project_keys", "project_roles") {
+		old, _ := d.GetChange("project_keys")
+		projectKeys, roles := projectGroupParams(d)
+
+		var removed []string
+		for _, projectKey := range castToStringArr(old.(*schema.Set).List()) {
+			if !contains(projectKeys, projectKey) {
+				removed = append(removed, projectKey)
+			}
+		}
+		if err := removeGroupFromProjects(m.(*resty.Client), d.Id(), removed); err != nil {
+			return err
+		}
+		if err := addGroupToProjects(m.(*resty.Client), d.Id(), projectKeys, roles); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(group.Name)
 	return resourceGroupRead(d, m)
 }
 
 func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
+	projectKeys, _ := projectGroupParams(d)
+	if err := removeGroupFromProjects(m.(*resty.Client), d.Id(), projectKeys); err != nil {
+		return err
+	}
+
 	_, err := m.(*resty.Client).R().Delete(groupsEndpoint + d.Id())
 	return err
 }
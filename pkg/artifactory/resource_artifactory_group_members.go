@@ -0,0 +1,120 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceArtifactoryGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createGroupMembers,
+		ReadContext:   readGroupMembers,
+		UpdateContext: updateGroupMembers,
+		DeleteContext: deleteGroupMembers,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manage the members of a group defined elsewhere. Every other attribute of the group is left untouched.",
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The name of the group. It must already exist.",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "The usernames of the members of the group. Any other member is removed from the group.",
+			},
+		},
+	}
+}
+
+func getGroupWithUsers(client *resty.Client, groupName string) (*Group, *resty.Response, error) {
+	group := Group{}
+	resp, err := client.R().
+		SetResult(&group).
+		AddRetryCondition(neverRetry).
+		Get(fmt.Sprintf("%s%s?includeUsers=true", groupsEndpoint, groupName))
+	return &group, resp, err
+}
+
+// setGroupMembers replaces the members of the group. The group is read first and written back as a whole, since the
+// update API would otherwise reset the attributes missing from the payload
+func setGroupMembers(client *resty.Client, groupName string, members []string) error {
+	group, resp, err := getGroupWithUsers(client, groupName)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return fmt.Errorf("group %s does not exist. It must be created before its members can be managed", groupName)
+		}
+		return err
+	}
+
+	group.UsersNames = members
+	_, err = client.R().SetBody(group).Put(groupsEndpoint + groupName)
+	return err
+}
+
+func createGroupMembers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	groupName := d.Get("group_name").(string)
+	if err := setGroupMembers(m.(*resty.Client), groupName, castToStringArr(d.Get("members").(*schema.Set).List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(groupName)
+	return readGroupMembers(ctx, d, m)
+}
+
+func readGroupMembers(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	group, resp, err := getGroupWithUsers(m.(*resty.Client), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	setValue := mkLens(d)
+	setValue("group_name", group.Name)
+	errors := setValue("members", schema.NewSet(schema.HashString, castToInterfaceArr(group.UsersNames)))
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed saving state for group members %q", errors)
+	}
+
+	return nil
+}
+
+func updateGroupMembers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("members") {
+		if err := setGroupMembers(m.(*resty.Client), d.Id(), castToStringArr(d.Get("members").(*schema.Set).List())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readGroupMembers(ctx, d, m)
+}
+
+func deleteGroupMembers(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := setGroupMembers(m.(*resty.Client), d.Id(), []string{})
+	// there are no members left to remove when the group itself is gone
+	if err != nil {
+		if exists, existsErr := groupExists(m.(*resty.Client), d.Id()); existsErr != nil || exists {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
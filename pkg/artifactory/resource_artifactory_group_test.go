@@ -277,6 +277,141 @@ func TestAccGroup_unmanagedmembers(t *testing.T) {
 	})
 }
 
+func TestAccGroup_projects(t *testing.T) {
+	_, rfqn, groupName := mkNames("test-group-projects", "artifactory_group")
+	projectKey := fmt.Sprintf("t%d", randomInt())
+
+	templates := []string{
+		`
+		resource "artifactory_group" "{{ .groupName }}" {
+			name         = "{{ .groupName }}"
+			project_keys = ["{{ .projectKey }}"]
+		}
+		`,
+		`
+		resource "artifactory_group" "{{ .groupName }}" {
+			name          = "{{ .groupName }}"
+			project_keys  = ["{{ .projectKey }}"]
+			project_roles = ["Developer", "Viewer"]
+		}
+		`,
+		`
+		resource "artifactory_group" "{{ .groupName }}" {
+			name = "{{ .groupName }}"
+		}
+		`,
+	}
+	configs := []string{}
+	for step, template := range templates {
+		configs = append(configs, executeTemplate(fmt.Sprint(step), template, map[string]string{"groupName": groupName, "projectKey": projectKey}))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createProject(t, projectKey)
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			deleteProject(t, projectKey)
+			return testAccCheckGroupDestroy(rfqn)(s)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configs[0],
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rfqn, "project_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rfqn, "project_keys.*", projectKey),
+					resource.TestCheckResourceAttr(rfqn, "project_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rfqn, "project_roles.*", "Viewer"),
+				),
+			},
+			{
+				Config: configs[1],
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rfqn, "project_roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(rfqn, "project_roles.*", "Developer"),
+				),
+			},
+			{
+				Config: configs[2],
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rfqn, "project_keys.#", "0"),
+					testAccDirectCheckProjectGroup(projectKey, groupName, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupMembers(t *testing.T) {
+	_, rfqn, groupName := mkNames("test-group-members", "artifactory_group")
+	membersFqrn := fmt.Sprintf("artifactory_group_members.%s", groupName)
+
+	templates := []string{
+		`
+		resource "artifactory_group" "{{ .groupName }}" {
+			name        = "{{ .groupName }}"
+			description = "Test group"
+			auto_join   = true
+		}
+
+		resource "artifactory_group_members" "{{ .groupName }}" {
+			group_name = artifactory_group.{{ .groupName }}.name
+			members    = ["anonymous", "admin"]
+		}
+		`,
+		`
+		resource "artifactory_group" "{{ .groupName }}" {
+			name        = "{{ .groupName }}"
+			description = "Test group"
+			auto_join   = true
+		}
+
+		resource "artifactory_group_members" "{{ .groupName }}" {
+			group_name = artifactory_group.{{ .groupName }}.name
+			members    = ["anonymous"]
+		}
+		`,
+	}
+	configs := []string{}
+	for step, template := range templates {
+		configs = append(configs, executeTemplate(fmt.Sprint(step), template, map[string]string{"groupName": groupName}))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckGroupDestroy(rfqn),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configs[0],
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersFqrn, "group_name", groupName),
+					resource.TestCheckResourceAttr(membersFqrn, "members.#", "2"),
+					testAccDirectCheckGroupMembership(membersFqrn, 2),
+				),
+			},
+			{
+				Config: configs[1],
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersFqrn, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr(membersFqrn, "members.*", "anonymous"),
+					testAccDirectCheckGroupMembership(membersFqrn, 1),
+					// the attributes managed by the group resource are left untouched
+					resource.TestCheckResourceAttr(rfqn, "description", "Test group"),
+					resource.TestCheckResourceAttr(rfqn, "auto_join", "true"),
+				),
+			},
+			{
+				ResourceName:      membersFqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGroupDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		provider, _ := testAccProviders["artifactory"]()
@@ -322,3 +457,22 @@ func testAccDirectCheckGroupMembership(id string, expectedCount int) func(*terra
 		return nil
 	}
 }
+
+func testAccDirectCheckProjectGroup(projectKey, groupName string, expected bool) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		provider, _ := testAccProviders["artifactory"]()
+		client := provider.Meta().(*resty.Client)
+
+		resp, err := client.R().AddRetryCondition(neverRetry).Get(projectGroupUrl(projectKey, groupName))
+		found := err == nil
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return err
+		}
+
+		if found != expected {
+			return fmt.Errorf("error: expected group %s in project %s to be %t, got %t", groupName, projectKey, expected, found)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,302 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const itemStorageEndpoint = "artifactory/api/storage/"
+
+var propertyKeyRegex = regexp.MustCompile(`^[^\s,|=;\\]+$`)
+
+type ItemProperties struct {
+	Uri        string              `json:"uri"`
+	Properties map[string][]string `json:"properties"`
+}
+
+func resourceArtifactoryItemProperties() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createItemProperties,
+		ReadContext:   readItemProperties,
+		UpdateContext: updateItemProperties,
+		DeleteContext: deleteItemProperties,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importItemProperties,
+		},
+		Description: "Manage the properties of an artifact or folder. Only the properties listed in this resource are managed, " +
+			"any other property set on the item is left untouched.\n" +
+			"https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-SetItemProperties",
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: repoKeyValidator,
+				Description:  "The repository containing the item.",
+			},
+			"item_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				StateFunc: func(value interface{}) string {
+					return strings.Trim(value.(string), "/")
+				},
+				Description: "The path of the artifact or folder within the repository, e.g. 'org/acme/foo/1.0/foo-1.0.jar'.",
+			},
+			"property": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(propertyKeyRegex, "property keys cannot contain spaces or any of ',|=;\\'"),
+							Description:  "The property key.",
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Description: "The values of the property. Values may contain any character, the ones the storage API uses as separators are escaped.",
+						},
+					},
+				},
+				Description: "The properties to set on the item, each key may only be given once.",
+			},
+		},
+
+		CustomizeDiff: itemPropertiesDiff,
+	}
+}
+
+// itemPropertiesDiff rejects a property given more than once, the values of the blocks would otherwise be merged
+// into a single property and read back as a change
+func itemPropertiesDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	keys := map[string]bool{}
+	for _, property := range diff.Get("property").(*schema.Set).List() {
+		key := property.(map[string]interface{})["key"].(string)
+		if key == "" {
+			continue
+		}
+		if keys[key] {
+			return fmt.Errorf("property %s is set more than once, list all of its values in a single property block", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+func itemPropertiesId(repoKey, itemPath string) string {
+	return fmt.Sprintf("%s/%s", repoKey, strings.Trim(itemPath, "/"))
+}
+
+// escapePropertyValue escapes the characters the storage API uses as separators, including commas which separate
+// multiple values of the same property
+func escapePropertyValue(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `,`, `\,`, `|`, `\|`, `=`, `\=`, `;`, `\;`)
+	return replacer.Replace(value)
+}
+
+func unpackItemProperties(d *schema.ResourceData) map[string][]string {
+	properties := map[string][]string{}
+	for _, property := range d.Get("property").(*schema.Set).List() {
+		property := property.(map[string]interface{})
+		properties[property["key"].(string)] = castToStringArr(property["values"].(*schema.Set).List())
+	}
+	return properties
+}
+
+func packItemProperties(properties map[string][]string) []interface{} {
+	var packed []interface{}
+	for key, values := range properties {
+		packed = append(packed, map[string]interface{}{
+			"key":    key,
+			"values": schema.NewSet(schema.HashString, castToInterfaceArr(values)),
+		})
+	}
+	return packed
+}
+
+// formatItemProperties formats the properties as the storage API expects them, e.g. 'environment=qa,prod;team=terraform'
+func formatItemProperties(properties map[string][]string) string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		var values []string
+		for _, value := range properties[key] {
+			values = append(values, escapePropertyValue(value))
+		}
+		sort.Strings(values)
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, strings.Join(values, ",")))
+	}
+	return strings.Join(pairs, ";")
+}
+
+func getItem(client *resty.Client, repoKey, itemPath string) (*resty.Response, error) {
+	return client.R().AddRetryCondition(neverRetry).Get(itemStorageEndpoint + itemPropertiesId(repoKey, itemPath))
+}
+
+func setItemProperties(client *resty.Client, repoKey, itemPath string, properties map[string][]string) error {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	_, err := client.R().
+		SetQueryParam("properties", formatItemProperties(properties)).
+		SetQueryParam("recursive", "0").
+		Put(itemStorageEndpoint + itemPropertiesId(repoKey, itemPath))
+	return err
+}
+
+func removeItemProperties(client *resty.Client, repoKey, itemPath string, keys []string) (*resty.Response, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	sort.Strings(keys)
+
+	return client.R().
+		SetQueryParam("properties", strings.Join(keys, ",")).
+		SetQueryParam("recursive", "0").
+		Delete(itemStorageEndpoint + itemPropertiesId(repoKey, itemPath))
+}
+
+func createItemProperties(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*resty.Client)
+	repoKey := d.Get("repo_key").(string)
+	itemPath := d.Get("item_path").(string)
+
+	resp, err := getItem(client, repoKey, itemPath)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return diag.Errorf("item %s does not exist. It must be deployed before its properties can be managed", itemPropertiesId(repoKey, itemPath))
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := setItemProperties(client, repoKey, itemPath, unpackItemProperties(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(itemPropertiesId(repoKey, itemPath))
+	return readItemProperties(ctx, d, m)
+}
+
+func readItemProperties(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*resty.Client)
+	repoKey := d.Get("repo_key").(string)
+	itemPath := d.Get("item_path").(string)
+
+	resp, err := getItem(client, repoKey, itemPath)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	itemProperties := ItemProperties{}
+	resp, err = client.R().
+		SetResult(&itemProperties).
+		AddRetryCondition(neverRetry).
+		Get(itemStorageEndpoint + itemPropertiesId(repoKey, itemPath) + "?properties")
+	// Artifactory answers with a 404 when the item exists but has no properties at all
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return diag.FromErr(err)
+	}
+
+	// only keep track of the properties this resource manages, unless it's being imported
+	managed := unpackItemProperties(d)
+	properties := map[string][]string{}
+	for key, values := range itemProperties.Properties {
+		if _, ok := managed[key]; ok || len(managed) == 0 {
+			properties[key] = values
+		}
+	}
+
+	setValue := mkLens(d)
+	setValue("repo_key", repoKey)
+	setValue("item_path", itemPath)
+	errors := setValue("property", packItemProperties(properties))
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack item properties %q", errors)
+	}
+
+	return nil
+}
+
+func updateItemProperties(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*resty.Client)
+	repoKey := d.Get("repo_key").(string)
+	itemPath := d.Get("item_path").(string)
+
+	if d.HasChange("property") {
+		old, _ := d.GetChange("property")
+		properties := unpackItemProperties(d)
+
+		var removed []string
+		for _, property := range old.(*schema.Set).List() {
+			key := property.(map[string]interface{})["key"].(string)
+			if _, ok := properties[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+		if _, err := removeItemProperties(client, repoKey, itemPath, removed); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := setItemProperties(client, repoKey, itemPath, properties); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readItemProperties(ctx, d, m)
+}
+
+func deleteItemProperties(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var keys []string
+	for key := range unpackItemProperties(d) {
+		keys = append(keys, key)
+	}
+
+	resp, err := removeItemProperties(m.(*resty.Client), d.Get("repo_key").(string), d.Get("item_path").(string), keys)
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func importItemProperties(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import id %q, expected <repo_key>/<item_path>", d.Id())
+	}
+
+	d.Set("repo_key", parts[0])
+	d.Set("item_path", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
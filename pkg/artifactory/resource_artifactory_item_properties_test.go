@@ -0,0 +1,133 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccItemProperties_full(t *testing.T) {
+	_, fqrn, name := mkNames("item-properties", "artifactory_item_properties")
+	const itemPropertiesTemplate = `
+		resource "artifactory_item_properties" "%s" {
+			repo_key  = "example-repo-local"
+			item_path = "crash.zip"
+			%s
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			err := uploadTestFile(getTestResty(t), "../../samples/crash.zip", "example-repo-local/crash.zip", "application/zip")
+			if err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy:      verifyDeleted(fqrn, testCheckItemProperties),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(itemPropertiesTemplate, name, `
+			property {
+				key    = "environment"
+				values = ["qa", "prod"]
+			}
+			property {
+				key    = "team"
+				values = ["terraform, core"]
+			}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repo_key", "example-repo-local"),
+					resource.TestCheckResourceAttr(fqrn, "item_path", "crash.zip"),
+					resource.TestCheckResourceAttr(fqrn, "property.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "property.*", map[string]string{"key": "environment", "values.#": "2"}),
+					resource.TestCheckTypeSetElemAttr(fqrn, "property.*.values.*", "qa"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "property.*.values.*", "prod"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "property.*", map[string]string{"key": "team", "values.#": "1"}),
+					resource.TestCheckTypeSetElemAttr(fqrn, "property.*.values.*", "terraform, core"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(itemPropertiesTemplate, name, `
+			property {
+				key    = "environment"
+				values = ["prod"]
+			}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "property.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "property.*", map[string]string{"key": "environment", "values.#": "1"}),
+					resource.TestCheckTypeSetElemAttr(fqrn, "property.*.values.*", "prod"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateId:     "example-repo-local/crash.zip",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccItemProperties_missingItem(t *testing.T) {
+	_, _, name := mkNames("item-properties", "artifactory_item_properties")
+	config := fmt.Sprintf(`
+		resource "artifactory_item_properties" "%s" {
+			repo_key   = "example-repo-local"
+			item_path  = "does/not/exist-%s.zip"
+
+			property {
+				key    = "team"
+				values = ["terraform"]
+			}
+		}
+	`, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*does not exist. It must be deployed before its properties can be managed.*"),
+			},
+		},
+	})
+}
+
+func TestFormatItemProperties(t *testing.T) {
+	formatted := formatItemProperties(map[string][]string{
+		"team":        {"terraform, core"},
+		"environment": {"qa", "prod"},
+		"build":       {`a|b=c;d\e`},
+	})
+
+	if expected := `build=a\|b\=c\;d\\e;environment=prod,qa;team=terraform\, core`; formatted != expected {
+		t.Errorf("expected %s, got %s", expected, formatted)
+	}
+}
+
+func TestItemPropertiesDuplicateKey(t *testing.T) {
+	_, err := resourceArtifactoryItemProperties().Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"repo_key":  "example-repo-local",
+		"item_path": "crash.zip",
+		"property": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"terraform"}},
+			map[string]interface{}{"key": "team", "values": []interface{}{"core"}},
+		},
+	}), nil)
+	if err == nil || !strings.Contains(err.Error(), "property team is set more than once") {
+		t.Errorf("expected a property given twice to be rejected, got %v", err)
+	}
+}
+
+func testCheckItemProperties(id string, request *resty.Request) (*resty.Response, error) {
+	return request.AddRetryCondition(neverRetry).Get(itemStorageEndpoint + id + "?properties")
+}
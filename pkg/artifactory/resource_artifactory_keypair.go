@@ -5,6 +5,8 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
@@ -226,6 +228,28 @@ func verifyKeyPair(id string, request *resty.Request) (*resty.Response, error) {
 	return request.Head(keypairEndPoint + id)
 }
 
+// mkKeyPairExistsDiff fails the plan when one of the given attributes references a keypair that doesn't exist.
+// Keypairs created in the same apply aren't known at plan time and are skipped
+func mkKeyPairExistsDiff(attributes ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		for _, attribute := range attributes {
+			if !diff.NewValueKnown(attribute) {
+				continue
+			}
+			pairName, ok := diff.GetOk(attribute)
+			if !ok {
+				continue
+			}
+			resp, err := verifyKeyPair(pairName.(string), m.(*resty.Client).R())
+			if err != nil && resp != nil && resp.StatusCode() == http.StatusNotFound {
+				return fmt.Errorf("%s references keypair %s which does not exist", attribute, pairName)
+			}
+		}
+
+		return nil
+	}
+}
+
 func (kp KeyPairPayLoad) Id() string {
 	return kp.PairName
 }
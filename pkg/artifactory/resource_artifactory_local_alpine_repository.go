@@ -1,6 +1,7 @@
 package artifactory
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,7 +15,7 @@ var alpineLocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Sche
 }, compressionFormats)
 
 func resourceArtifactoryLocalAlpineRepository() *schema.Resource {
-	return mkResourceSchema(alpineLocalSchema, defaultPacker, unPackLocalAlpineRepository, func() interface{} {
+	resource := mkResourceSchema(alpineLocalSchema, defaultPacker, unPackLocalAlpineRepository, func() interface{} {
 		return &AlpineLocalRepo{
 			LocalRepositoryBaseParams: LocalRepositoryBaseParams{
 				PackageType: "alpine",
@@ -22,6 +23,9 @@ func resourceArtifactoryLocalAlpineRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkKeyPairExistsDiff("primary_keypair_ref"))
+
+	return resource
 }
 
 type AlpineLocalRepo struct {
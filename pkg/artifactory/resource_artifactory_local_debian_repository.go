@@ -1,6 +1,7 @@
 package artifactory
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,7 +26,7 @@ var debianLocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Sche
 
 func resourceArtifactoryLocalDebianRepository() *schema.Resource {
 
-	return mkResourceSchema(debianLocalSchema, defaultPacker, unPackLocalDebianRepository, func() interface{} {
+	resource := mkResourceSchema(debianLocalSchema, defaultPacker, unPackLocalDebianRepository, func() interface{} {
 		return &DebianLocalRepositoryParams{
 			LocalRepositoryBaseParams: LocalRepositoryBaseParams{
 				PackageType: "debian",
@@ -33,6 +34,9 @@ func resourceArtifactoryLocalDebianRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkKeyPairExistsDiff("primary_keypair_ref", "secondary_keypair_ref"))
+
+	return resource
 }
 
 type DebianLocalRepositoryParams struct {
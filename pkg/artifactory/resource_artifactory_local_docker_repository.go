@@ -1,20 +1,16 @@
 package artifactory
 
 import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var dockerV2LocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Schema{
-	"max_unique_tags": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		Computed: false,
-		Description: "The maximum number of unique tags of a single Docker image to store in this repository.\n" +
-			"Once the number tags for an image exceeds this setting, older tags are removed. A value of 0 (default) indicates there is no limit.\n" +
-			"This only applies to manifest v2",
-		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
-	},
+var dockerV2LocalSchema = mergeSchema(baseLocalRepoSchema, maxUniqueTagsSchema, map[string]*schema.Schema{
 	"tag_retention": {
 		Type:             schema.TypeInt,
 		Optional:         true,
@@ -33,27 +29,62 @@ var dockerV2LocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Sc
 		Computed:    true,
 		Description: "The Docker API version to use. This cannot be set",
 	},
+	"enable_token_authentication": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Enable token (Bearer) based authentication.",
+	},
 })
+
+// dockerV1LocalSchema has no block_pushing_schema1, manifest v2 schema 1 images can't be pushed to a V1 registry anyway.
+// The other V2 settings are computed only, so configuring them is rejected at plan time
 var dockerV1LocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Schema{
 	"max_unique_tags": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		Computed: true,
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          0,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description: "The maximum number of unique tags of a single Docker image to store in this repository.\n" +
+			"Once the number tags for an image exceeds this setting, older tags are removed. A value of 0 (default) indicates there is no limit.",
 	},
 	"tag_retention": {
 		Type:     schema.TypeInt,
 		Computed: true,
 	},
-	"block_pushing_schema1": {
-		Type:     schema.TypeBool,
-		Computed: true,
-	},
 	"api_version": {
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"enable_token_authentication": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
 })
 
+// dockerTokenAuthenticationWarning warns about Docker repositories with token authentication turned off on an instance
+// without anonymous access, since the Docker client has no other way to authenticate against them. Reading the
+// anonymous access setting needs an admin, without it there's no warning
+func dockerTokenAuthenticationWarning(client *resty.Client, d *schema.ResourceData) diag.Diagnostics {
+	if enabled, ok := d.GetOkExists("enable_token_authentication"); !ok || enabled.(bool) {
+		return nil
+	}
+
+	generalSettings := GeneralSettings{}
+	_, err := client.R().SetResult(&generalSettings).AddRetryCondition(neverRetry).Get("artifactory/api/securityconfig")
+	if err != nil || generalSettings.AnonAccessEnabled {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("enable_token_authentication of %s is off while anonymous access is disabled", d.Get("key")),
+		Detail: "Docker clients only authenticate with a token, they won't be able to use this repository. " +
+			"Set enable_token_authentication = true or enable anonymous access.",
+		AttributePath: cty.GetAttrPath("enable_token_authentication"),
+	}}
+}
+
 func resourceArtifactoryLocalDockerV2Repository() *schema.Resource {
 
 	packer := universalPack(
@@ -79,10 +110,17 @@ func resourceArtifactoryLocalDockerV1Repository() *schema.Resource {
 	// this is necessary because of the pointers
 	skeema := mergeSchema(map[string]*schema.Schema{}, dockerV1LocalSchema)
 	for key, value := range dockerV2LocalSchema {
-		skeema[key].Description = value.Description
+		if v1, ok := skeema[key]; ok && v1.Description == "" {
+			v1.Description = value.Description
+		}
 	}
 
-	return mkResourceSchema(skeema, defaultPacker, unPackLocalDockerV1Repository, func() interface{} {
+	packer := universalPack(
+		allHclPredicate(
+			noClass, schemaHasKey(skeema),
+		),
+	)
+	return mkResourceSchema(skeema, packer, unPackLocalDockerV1Repository, func() interface{} {
 		return &DockerLocalRepositoryParams{
 			LocalRepositoryBaseParams: LocalRepositoryBaseParams{
 				PackageType: "docker",
@@ -102,12 +140,15 @@ type DockerLocalRepositoryParams struct {
 	DockerApiVersion    string `hcl:"api_version" json:"dockerApiVersion"`
 	TagRetention        int    `hcl:"tag_retention" json:"dockerTagRetention"`
 	BlockPushingSchema1 bool   `hcl:"block_pushing_schema1" json:"blockPushingSchema1"`
+	// EnableTokenAuthentication is a pointer so V1 repositories, which can't set it, leave it to Artifactory
+	EnableTokenAuthentication *bool `hcl:"enable_token_authentication" json:"enableTokenAuthentication,omitempty"`
 }
 
 func unPackLocalDockerV1Repository(data *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{ResourceData: data}
 	repo := DockerLocalRepositoryParams{
 		LocalRepositoryBaseParams: unpackBaseRepo("local", data, "docker"),
-		MaxUniqueTags:             0,
+		MaxUniqueTags:             d.getInt("max_unique_tags", false),
 		DockerApiVersion:          "V1",
 		TagRetention:              1,
 		BlockPushingSchema1:       false,
@@ -123,6 +164,7 @@ func unPackLocalDockerV2Repository(data *schema.ResourceData) (interface{}, stri
 		DockerApiVersion:          "V2",
 		TagRetention:              d.getInt("tag_retention", false),
 		BlockPushingSchema1:       d.getBool("block_pushing_schema1", false),
+		EnableTokenAuthentication: d.getBoolRef("enable_token_authentication", false),
 	}
 
 	return repo, repo.Id(), nil
@@ -15,5 +15,9 @@ func resourceArtifactoryLocalGenericRepository(pkt string) *schema.Resource {
 		repo := unpackBaseRepo("local", data, pkt)
 		return repo, repo.Id(), nil
 	}
-	return mkResourceSchema(baseLocalRepoSchema, inSchema(baseRemoteSchema), unpack, constructor)
+	skeema := baseLocalRepoSchema
+	if _, ok := defaultRepoLayoutRefs[pkt]; ok {
+		skeema = mergeSchema(baseLocalRepoSchema, repoLayoutRefSchema("local", pkt))
+	}
+	return mkResourceSchema(skeema, inSchema(baseRemoteSchema), unpack, constructor)
 }
@@ -1,6 +1,8 @@
 package artifactory
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -27,15 +29,6 @@ func resourceArtifactoryLocalJavaRepository(repoType string, suppressPom bool) *
 				" self-overriding naming pattern of artifactId-version-SNAPSHOT.type\nDeployer: Respects the settings " +
 				"in the Maven client that is deploying the artifact.",
 		},
-		"max_unique_snapshots": {
-			Type:             schema.TypeInt,
-			Optional:         true,
-			Default:          0,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
-			Description: "The maximum number of unique snapshots of a single artifact to store.\nOnce the number of " +
-				"snapshots exceeds this setting, older versions are removed.\nA value of 0 (default) indicates there is " +
-				"no limit, and unique snapshots are not cleaned up.",
-		},
 		"handle_releases": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -57,7 +50,7 @@ func resourceArtifactoryLocalJavaRepository(repoType string, suppressPom bool) *
 				"deployed path, Artifactory rejects the deployment with a \"409 Conflict\" error.\n  You can disable this " +
 				"behavior by setting the Suppress POM Consistency Checks checkbox.",
 		},
-	})
+	}, maxUniqueSnapshotsSchema, repoLayoutRefSchema("local", repoType))
 	type JavaLocalRepositoryParams struct {
 		LocalRepositoryBaseParams
 		ChecksumPolicyType           string `hcl:"checksum_policy_type" json:"checksumPolicyType"`
@@ -82,13 +75,33 @@ func resourceArtifactoryLocalJavaRepository(repoType string, suppressPom bool) *
 
 		return repo, repo.Id(), nil
 	}
-	return mkResourceSchema(javaLocalSchema, inSchema(javaLocalSchema), unPackLocalJavaRepository, func() interface{} {
+	resource := mkResourceSchema(javaLocalSchema, inSchema(javaLocalSchema), unPackLocalJavaRepository, func() interface{} {
 		return &JavaLocalRepositoryParams{
 			LocalRepositoryBaseParams: LocalRepositoryBaseParams{
-				PackageType: repoType,
-				Rclass:      "local",
+				PackageType:   repoType,
+				Rclass:        "local",
+				RepoLayoutRef: defaultRepoLayoutRefs[repoType],
 			},
 			SuppressPomConsistencyChecks: suppressPom,
 		}
 	})
+	if repoType == "sbt" || repoType == "ivy" {
+		// artifactory_local_sbt_repository and artifactory_local_ivy_repository used to be generic-like, their state is
+		// given the defaults of the attributes they gained
+		resource.SchemaVersion = 1
+		resource.StateUpgraders = []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resourceArtifactoryLocalGenericRepository(repoType).CoreConfigSchema().ImpliedType(),
+			Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+				for key, attribute := range javaLocalSchema {
+					if _, ok := rawState[key]; !ok && attribute.Default != nil {
+						rawState[key] = attribute.Default
+					}
+				}
+				return rawState, nil
+			},
+		}}
+	}
+
+	return resource
 }
@@ -4,22 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var nugetLocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Schema{
-	"max_unique_snapshots": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		Default:  0,
-		Description: "The maximum number of unique snapshots of a single artifact to store.\nOnce the number of " +
-			"snapshots exceeds this setting, older versions are removed.\nA value of 0 (default) indicates there is no limit, and unique snapshots are not cleaned up.",
-	},
-
-	"force_nuget_authentication": {
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Force basic authentication credentials in order to use this repository.",
-	},
-})
+var nugetLocalSchema = mergeSchema(baseLocalRepoSchema, maxUniqueSnapshotsSchema, nugetForceAuth.Schema)
 
 func resourceArtifactoryLocalNugetRepository() *schema.Resource {
 
@@ -46,7 +31,7 @@ func unPackLocalNugetRepository(data *schema.ResourceData) (interface{}, string,
 	repo := NugetLocalRepositoryParams{
 		LocalRepositoryBaseParams: unpackBaseRepo("local", data, "nuget"),
 		MaxUniqueSnapshots:        d.getInt("max_unique_snapshots", false),
-		ForceNugetAuthentication:  d.getBool("force_nuget_authentication", false),
+		ForceNugetAuthentication:  d.getBool(nugetForceAuth.Attribute, false),
 	}
 
 	return repo, repo.Id(), nil
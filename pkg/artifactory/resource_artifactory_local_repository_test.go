@@ -1,15 +1,22 @@
 package artifactory
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccLocalAlpineRepository(t *testing.T) {
@@ -264,11 +271,13 @@ func TestAccLocalDockerV1Repository(t *testing.T) {
 
 	_, fqrn, name := mkNames("dockerv1-local", "artifactory_local_docker_v1_repository")
 	params := map[string]interface{}{
-		"name": name,
+		"max_tags": randSelect(0, 5, 10),
+		"name":     name,
 	}
-	localRepositoryBasic := executeTemplate("TestAccLocalDockerv2Repository", `
+	localRepositoryBasic := executeTemplate("TestAccLocalDockerV1Repository", `
 		resource "artifactory_local_docker_v1_repository" "{{ .name }}" {
-			key 	     = "{{ .name }}"
+			key 	        = "{{ .name }}"
+			max_unique_tags = {{ .max_tags }}
 		}
 	`, params)
 	resource.Test(t, resource.TestCase{
@@ -280,14 +289,218 @@ func TestAccLocalDockerV1Repository(t *testing.T) {
 				Config: localRepositoryBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
-					resource.TestCheckResourceAttr(fqrn, "block_pushing_schema1", "false"),
+					resource.TestCheckResourceAttr(fqrn, "api_version", "V1"),
 					resource.TestCheckResourceAttr(fqrn, "tag_retention", "1"),
-					resource.TestCheckResourceAttr(fqrn, "max_unique_tags", "0"),
+					resource.TestCheckResourceAttr(fqrn, "max_unique_tags", fmt.Sprintf("%d", params["max_tags"])),
+					resource.TestCheckNoResourceAttr(fqrn, "block_pushing_schema1"),
 				),
 			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLocalDockerV1RepositoryRejectsV2Settings(t *testing.T) {
+	_, _, name := mkNames("dockerv1-local", "artifactory_local_docker_v1_repository")
+	for setting, expected := range map[string]string{
+		"block_pushing_schema1 = true":       `An argument named "block_pushing_schema1" is not expected here`,
+		"tag_retention = 5":                  `Value for unconfigurable attribute`,
+		"enable_token_authentication = true": `Value for unconfigurable attribute`,
+		"api_version = \"V2\"":               `Value for unconfigurable attribute`,
+	} {
+		config := fmt.Sprintf(`
+			resource "artifactory_local_docker_v1_repository" "%[1]s" {
+				key = "%[1]s"
+				%[2]s
+			}
+		`, name, setting)
+
+		resource.Test(t, resource.TestCase{
+			ProviderFactories: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile(expected),
+				},
+			},
+		})
+	}
+}
+
+func TestLocalDockerV2RepositoryTokenAuthenticationWarning(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "docker-local")
+
+	docker := resourceArtifactoryLocalDockerV2Repository()
+	for _, config := range []struct {
+		tokenAuthentication bool
+		anonymousAccess     bool
+		warned              bool
+	}{
+		{tokenAuthentication: false, anonymousAccess: false, warned: true},
+		{tokenAuthentication: false, anonymousAccess: true, warned: false},
+		{tokenAuthentication: true, anonymousAccess: false, warned: false},
+	} {
+		repo.responses["api/securityconfig"] = map[string]interface{}{"anonAccessEnabled": config.anonymousAccess}
+		d := schema.TestResourceDataRaw(t, docker.Schema, map[string]interface{}{
+			"key":                         "docker-local",
+			"enable_token_authentication": config.tokenAuthentication,
+		})
+		diags := docker.CreateContext(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatalf("failed to create the repository: %v", diags)
+		}
+		warned := len(diags) == 1 && diags[0].Severity == diag.Warning && strings.Contains(diags[0].Summary, "enable_token_authentication")
+		if warned != config.warned || (!config.warned && len(diags) > 0) {
+			t.Errorf("expected a token authentication warning to be %v for %+v, got %v", config.warned, config, diags)
+		}
+	}
+}
+
+func TestRepoLayoutRefKeepsExistingLayout(t *testing.T) {
+	for packageType, res := range map[string]*schema.Resource{
+		"gitlfs":  resourceArtifactoryLocalGenericRepository("gitlfs"),
+		"conan":   resourceArtifactoryRemoteConanRepository(),
+		"vagrant": resourceArtifactoryRemoteVagrantRepository(),
+	} {
+		res := res
+		expectedLayout := defaultRepoLayoutRefs[packageType]
+		t.Run(packageType, func(t *testing.T) {
+			client, repo := mkFakeRepositoryServer(t, "repo")
+			config := map[string]interface{}{"key": "repo"}
+			if _, ok := res.Schema["url"]; ok {
+				config["url"] = "https://example.com/"
+			}
+
+			d := schema.TestResourceDataRaw(t, res.Schema, config)
+			if diags := res.CreateContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("failed to create the repository: %v", diags)
+			}
+			if repo.saved["repoLayoutRef"] != expectedLayout {
+				t.Errorf("expected a new repository to get %s, got %v", expectedLayout, repo.saved["repoLayoutRef"])
+			}
+
+			// an existing repository with another layout, e.g. imported, keeps it while repo_layout_ref isn't configured
+			state := d.State()
+			state.Attributes["repo_layout_ref"] = "maven-2-default"
+			diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff != nil && diff.Attributes["repo_layout_ref"] != nil {
+				t.Errorf("expected no repo_layout_ref diff, got %v", diff.Attributes["repo_layout_ref"])
+			}
+		})
+	}
+}
+
+func TestRepoLayoutRefDiffSkipsCheck(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := resourceArtifactoryLocalGenericRepository("gitlfs")
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"key": "repo"})
+	d.SetId("repo")
+	state := d.State()
+	state.Attributes["repo_layout_ref"] = "simple-default"
+
+	for _, config := range []struct {
+		layout   string
+		requests int
+	}{
+		{"simple-default", 0},
+		{"maven-2-default", 1},
+	} {
+		requests = 0
+		raw := terraform.NewResourceConfigRaw(map[string]interface{}{"key": "repo", "repo_layout_ref": config.layout})
+		if _, err := res.Diff(context.Background(), state, raw, client); err != nil {
+			t.Errorf("expected a 403 to skip the repo_layout_ref check for %s, got %v", config.layout, err)
+		}
+		if requests != config.requests {
+			t.Errorf("expected %d requests for repo_layout_ref %s, got %d", config.requests, config.layout, requests)
+		}
+	}
+}
+
+func TestForceAuthIsSentInItsJsonField(t *testing.T) {
+	for name, config := range map[string]struct {
+		res       *schema.Resource
+		forceAuth ForceAuth
+	}{
+		"local nuget":   {resourceArtifactoryLocalNugetRepository(), nugetForceAuth},
+		"remote nuget":  {resourceArtifactoryRemoteNugetRepository(), nugetForceAuth},
+		"virtual nuget": {resourceArtifactoryNugetVirtualRepository(), nugetForceAuth},
+		"remote conan":  {resourceArtifactoryRemoteConanRepository(), conanForceAuth},
+		"virtual conan": {resourceArtifactoryConanVirtualRepository(), conanForceAuth},
+	} {
+		client, repo := mkFakeRepositoryServer(t, "repo")
+		raw := map[string]interface{}{"key": "repo", config.forceAuth.Attribute: true}
+		if url, ok := config.res.Schema["url"]; ok && url.Required {
+			raw["url"] = "https://example.com/"
+		}
+
+		d := schema.TestResourceDataRaw(t, config.res.Schema, raw)
+		if diags := config.res.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("failed to create the %s repository: %v", name, diags)
+		}
+		if repo.saved[config.forceAuth.JsonField] != true {
+			t.Errorf("expected %s to be sent for the %s repository, got %v", config.forceAuth.JsonField, name, repo.saved)
+		}
+		if d.Get(config.forceAuth.Attribute) != true {
+			t.Errorf("expected %s to be read back for the %s repository, got %v", config.forceAuth.Attribute, name, d.Get(config.forceAuth.Attribute))
+		}
+	}
+}
+
+func TestAccLocalGitLfsRepositoryKeepsLayout(t *testing.T) {
+	_, fqrn, name := mkNames("gitlfs-local", "artifactory_local_gitlfs_repository")
+	const withLayout = `
+		resource "artifactory_local_gitlfs_repository" "%[1]s" {
+		  key             = "%[1]s"
+		  repo_layout_ref = "maven-2-default"
+		}
+	`
+	const withoutLayout = `
+		resource "artifactory_local_gitlfs_repository" "%[1]s" {
+		  key = "%[1]s"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(withLayout, name),
+				Check:  resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "maven-2-default"),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the repository keeps the layout it has rather than going back to simple-default
+				Config:             fmt.Sprintf(withoutLayout, name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 		},
 	})
 }
+
 func TestAccLocalDockerV2Repository(t *testing.T) {
 
 	_, fqrn, name := mkNames("dockerv2-local", "artifactory_local_docker_v2_repository")
@@ -295,6 +508,7 @@ func TestAccLocalDockerV2Repository(t *testing.T) {
 		"block":     randBool(),
 		"retention": randSelect(1, 5, 10),
 		"max_tags":  randSelect(0, 5, 10),
+		"token":     randBool(),
 		"name":      name,
 	}
 	localRepositoryBasic := executeTemplate("TestAccLocalDockerV2Repository", `
@@ -303,6 +517,7 @@ func TestAccLocalDockerV2Repository(t *testing.T) {
 			tag_retention = {{ .retention }}
 			max_unique_tags = {{ .max_tags }}
 			block_pushing_schema1 = {{ .block }}
+			enable_token_authentication = {{ .token }}
 		}
 	`, params)
 	resource.Test(t, resource.TestCase{
@@ -317,11 +532,39 @@ func TestAccLocalDockerV2Repository(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "block_pushing_schema1", fmt.Sprintf("%t", params["block"])),
 					resource.TestCheckResourceAttr(fqrn, "tag_retention", fmt.Sprintf("%d", params["retention"])),
 					resource.TestCheckResourceAttr(fqrn, "max_unique_tags", fmt.Sprintf("%d", params["max_tags"])),
+					resource.TestCheckResourceAttr(fqrn, "enable_token_authentication", fmt.Sprintf("%t", params["token"])),
+				),
+			},
+		},
+	})
+}
+func TestAccLocalGenericRepositoryWithSpecialCharacters(t *testing.T) {
+	_, fqrn, name := mkNames("generic-local", "artifactory_local_generic_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_local_generic_repository" "%[1]s" {
+			key         = "%[1]s"
+			description = "a & b <c>"
+			notes       = "\"quoted\" & 'single'"
+		}
+	`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Artifactory answers with the HTML escaped values, the empty plan after apply shows they're unescaped
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "description", "a & b <c>"),
+					resource.TestCheckResourceAttr(fqrn, "notes", `"quoted" & 'single'`),
 				),
 			},
 		},
 	})
 }
+
 func TestAccLocalNugetRepository(t *testing.T) {
 
 	_, fqrn, name := mkNames("nuget-local", "artifactory_local_nuget_repository")
@@ -407,6 +650,72 @@ func TestAccLocalMavenRepository(t *testing.T) {
 	})
 }
 
+func TestAccLocalMavenRepositoryUnlimitedSnapshots(t *testing.T) {
+	_, fqrn, name := mkNames("maven-local", "artifactory_local_maven_repository")
+	const template = `
+		resource "artifactory_local_maven_repository" "%s" {
+			key                  = "%s"
+			max_unique_snapshots = %d
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(template, name, name, -1),
+				ExpectError: regexp.MustCompile(".*expected max_unique_snapshots to be at least \\(0\\).*"),
+			},
+			{
+				// 0 means unlimited and has to survive the round trip rather than be mistaken for unset
+				Config: fmt.Sprintf(template, name, name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "max_unique_snapshots", "0"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLocalIvyRepository(t *testing.T) {
+
+	_, fqrn, name := mkNames("ivy-local", "artifactory_local_ivy_repository")
+	tempStruct := make(map[string]interface{})
+	copyInterfaceMap(commonJavaParams, tempStruct)
+
+	tempStruct["name"] = name
+	tempStruct["resource_name"] = strings.Split(fqrn, ".")[0]
+	tempStruct["suppress_pom_consistency_checks"] = false
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: executeTemplate(fqrn, localJavaRepositoryBasic, tempStruct),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "ivy-default"),
+					resource.TestCheckResourceAttr(fqrn, "checksum_policy_type", fmt.Sprintf("%s", tempStruct["checksum_policy_type"])),
+					resource.TestCheckResourceAttr(fqrn, "snapshot_version_behavior", fmt.Sprintf("%s", tempStruct["snapshot_version_behavior"])),
+					resource.TestCheckResourceAttr(fqrn, "max_unique_snapshots", fmt.Sprintf("%d", tempStruct["max_unique_snapshots"])),
+					resource.TestCheckResourceAttr(fqrn, "handle_releases", fmt.Sprintf("%v", tempStruct["handle_releases"])),
+					resource.TestCheckResourceAttr(fqrn, "handle_snapshots", fmt.Sprintf("%v", tempStruct["handle_snapshots"])),
+					resource.TestCheckResourceAttr(fqrn, "suppress_pom_consistency_checks", fmt.Sprintf("%v", tempStruct["suppress_pom_consistency_checks"])),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLocalGradleRepository(t *testing.T) {
 
 	_, fqrn, name := mkNames("gradle-local", "artifactory_local_gradle_repository")
@@ -438,6 +747,71 @@ func TestAccLocalGradleRepository(t *testing.T) {
 	})
 }
 
+func TestAccLocalSbtRepository(t *testing.T) {
+
+	_, fqrn, name := mkNames("sbt-local", "artifactory_local_sbt_repository")
+	tempStruct := make(map[string]interface{})
+	copyInterfaceMap(commonJavaParams, tempStruct)
+
+	tempStruct["name"] = name
+	tempStruct["resource_name"] = strings.Split(fqrn, ".")[0]
+	tempStruct["handle_snapshots"] = false
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: executeTemplate(fqrn, localJavaRepositoryBasic, tempStruct),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "sbt"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "sbt-default"),
+					resource.TestCheckResourceAttr(fqrn, "checksum_policy_type", fmt.Sprintf("%s", tempStruct["checksum_policy_type"])),
+					resource.TestCheckResourceAttr(fqrn, "snapshot_version_behavior", fmt.Sprintf("%s", tempStruct["snapshot_version_behavior"])),
+					resource.TestCheckResourceAttr(fqrn, "max_unique_snapshots", fmt.Sprintf("%d", tempStruct["max_unique_snapshots"])),
+					resource.TestCheckResourceAttr(fqrn, "handle_releases", fmt.Sprintf("%v", tempStruct["handle_releases"])),
+					resource.TestCheckResourceAttr(fqrn, "handle_snapshots", fmt.Sprintf("%v", tempStruct["handle_snapshots"])),
+					resource.TestCheckResourceAttr(fqrn, "suppress_pom_consistency_checks", fmt.Sprintf("%v", tempStruct["suppress_pom_consistency_checks"])),
+				),
+			},
+		},
+	})
+}
+
+func TestLocalJavaRepositoryStateUpgrade(t *testing.T) {
+	for _, repoType := range []string{"sbt", "ivy"} {
+		res := resourceArtifactoryLocalJavaRepository(repoType, false)
+		if len(res.StateUpgraders) != 1 || res.SchemaVersion != 1 {
+			t.Fatalf("expected the generic-like %s state of version 0 to be upgraded, got version %d", repoType, res.SchemaVersion)
+		}
+
+		state, err := res.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{
+			"key":             repoType + "-local",
+			"repo_layout_ref": repoType + "-custom",
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]interface{}{
+			"key":                             repoType + "-local",
+			"repo_layout_ref":                 repoType + "-custom",
+			"checksum_policy_type":            "client-checksums",
+			"snapshot_version_behavior":       "unique",
+			"max_unique_snapshots":            0,
+			"handle_releases":                 true,
+			"handle_snapshots":                true,
+			"suppress_pom_consistency_checks": false,
+		}
+		for key, value := range expected {
+			if state[key] != value {
+				t.Errorf("expected %s of the %s state to be %v, got %v", key, repoType, value, state[key])
+			}
+		}
+	}
+}
+
 func TestAccLocalGenericRepository(t *testing.T) {
 
 	_, fqrn, name := mkNames("generic-local", "artifactory_local_generic_repository")
@@ -467,6 +841,127 @@ func TestAccLocalGenericRepository(t *testing.T) {
 	})
 }
 
+func TestAccLocalCranRepository(t *testing.T) {
+	_, fqrn, name := mkNames("cran-local", "artifactory_local_cran_repository")
+	localRepositoryBasic := fmt.Sprintf(`
+		resource "artifactory_local_cran_repository" "%[1]s" {
+		  key = "%[1]s"
+		}
+	`, name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: localRepositoryBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "cran"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestLocalRpmRepositoryIndexOnCreate(t *testing.T) {
+	var created map[string]interface{}
+	var indexed []string
+	failIndexing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/artifactory/api/repositories/") && r.Method == http.MethodPut:
+			created = map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case strings.HasPrefix(r.URL.Path, "/artifactory/api/repositories/"):
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(created)
+		case strings.HasPrefix(r.URL.Path, "/artifactory/api/yum/") && r.Method == http.MethodPost:
+			indexed = append(indexed, strings.TrimPrefix(r.URL.Path, "/artifactory/api/yum/")+"?"+r.URL.RawQuery)
+			if failIndexing {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rpm := resourceArtifactoryLocalRpmRepository()
+	create := func(key string, indexOnCreate bool) diag.Diagnostics {
+		d := schema.TestResourceDataRaw(t, rpm.Schema, map[string]interface{}{
+			"key":             key,
+			"index_on_create": indexOnCreate,
+		})
+		return rpm.CreateContext(context.Background(), d, client.SetRetryCount(0))
+	}
+
+	if diags := create("rpm-local", false); diags.HasError() || len(indexed) != 0 {
+		t.Fatalf("expected no metadata calculation by default, got %v and %v", indexed, diags)
+	}
+	if _, ok := created["indexOnCreate"]; ok {
+		t.Error("index_on_create is not a repository setting and must not be sent")
+	}
+
+	if diags := create("rpm-local", true); diags.HasError() || len(diags) != 0 {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+	if expected := "rpm-local?async=1"; len(indexed) != 1 || indexed[0] != expected {
+		t.Errorf("expected %s to be indexed, got %v", expected, indexed)
+	}
+
+	failIndexing = true
+	diags := create("rpm-local", true)
+	if diags.HasError() {
+		t.Fatalf("a failed metadata calculation must not fail the apply: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "rpm-local") {
+		t.Errorf("expected a single warning for the failed metadata calculation, got %v", diags)
+	}
+}
+
+func TestAccLocalVagrantRepository(t *testing.T) {
+	_, fqrn, name := mkNames("vagrant-local", "artifactory_local_vagrant_repository")
+	localRepositoryBasic := fmt.Sprintf(`
+		resource "artifactory_local_vagrant_repository" "%[1]s" {
+		  key = "%[1]s"
+		}
+	`, name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: localRepositoryBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "vagrant"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccLocalGenericRepositoryWithProjectAttributesGH318(t *testing.T) {
 
 	rand.Seed(time.Now().UnixNano())
@@ -590,6 +1085,28 @@ func TestAccLocalGenericRepositoryWithInvalidProjectEnvironmentsGH318(t *testing
 	})
 }
 
+func TestAccLocalRepositoryWithInvalidRepoLayoutRef(t *testing.T) {
+	_, fqrn, name := mkNames("generic-local", "artifactory_local_generic_repository")
+	localRepositoryBasic := executeTemplate("TestAccLocalGenericRepository", `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+		  key             = "{{ .name }}"
+		  repo_layout_ref = "not-a-layout"
+		}
+	`, map[string]interface{}{"name": name})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      localRepositoryBasic,
+				ExpectError: regexp.MustCompile(".*repo_layout_ref not-a-layout does not exist.*"),
+			},
+		},
+	})
+}
+
 func TestAccLocalNpmRepository(t *testing.T) {
 
 	_, fqrn, name := mkNames("npm-local", "artifactory_local_npm_repository")
@@ -635,6 +1152,17 @@ func mkTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCase) {
 		}
 	`, params)
 
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(resourceName, "key", name),
+		resource.TestCheckResourceAttr(resourceName, "package_type", repoType),
+		resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("Test repo for %s", name)),
+		resource.TestCheckResourceAttr(resourceName, "notes", fmt.Sprintf("Test repo for %s", name)),
+		resource.TestCheckResourceAttr(resourceName, "xray_index", fmt.Sprintf("%t", xrayIndex)),
+	}
+	if layout, ok := defaultRepoLayoutRefs[repoType]; ok {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, "repo_layout_ref", layout))
+	}
+
 	return t, resource.TestCase{
 		ProviderFactories: testAccProviders,
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -642,13 +1170,7 @@ func mkTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCase) {
 		Steps: []resource.TestStep{
 			{
 				Config: cfg,
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "key", name),
-					resource.TestCheckResourceAttr(resourceName, "package_type", repoType),
-					resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("Test repo for %s", name)),
-					resource.TestCheckResourceAttr(resourceName, "notes", fmt.Sprintf("Test repo for %s", name)),
-					resource.TestCheckResourceAttr(resourceName, "xray_index", fmt.Sprintf("%t", xrayIndex)),
-				),
+				Check:  resource.ComposeTestCheckFunc(checks...),
 			},
 		},
 	}
@@ -1,68 +1,89 @@
 package artifactory
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceArtifactoryLocalRpmRepository() *schema.Resource {
-	var rpmLocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Schema{
-		"yum_root_depth": {
-			Type:             schema.TypeInt,
-			Optional:         true,
-			Default:          0,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
-			Description: "The depth, relative to the repository's root folder, where RPM metadata is created. " +
-				"This is useful when your repository contains multiple RPM repositories under parallel hierarchies. " +
-				"For example, if your RPMs are stored under 'fedora/linux/$releasever/$basearch', specify a depth of 4.",
-		},
+var rpmLocalSchema = mergeSchema(baseLocalRepoSchema, map[string]*schema.Schema{
+	"yum_root_depth": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          0,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description: "The depth, relative to the repository's root folder, where RPM metadata is created. " +
+			"This is useful when your repository contains multiple RPM repositories under parallel hierarchies. " +
+			"For example, if your RPMs are stored under 'fedora/linux/$releasever/$basearch', specify a depth of 4.",
+	},
 
-		"calculate_yum_metadata": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Default:  false,
-		},
+	"calculate_yum_metadata": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
 
-		"enable_file_lists_indexing": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Default:  false,
-		},
+	"enable_file_lists_indexing": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
 
-		"yum_group_file_names": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          "",
-			ValidateDiagFunc: commaSeperatedList,
-			Description: "A list of XML file names containing RPM group component definitions. Artifactory includes " +
-				"the group definitions as part of the calculated RPM metadata, as well as automatically generating a " +
-				"gzipped version of the group files, if required.",
-		},
-	})
+	"yum_group_file_names": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "",
+		ValidateDiagFunc: commaSeperatedList,
+		StateFunc:        normalizeCommaSeperatedList,
+		Description: "A list of XML file names containing RPM group component definitions. Artifactory includes " +
+			"the group definitions as part of the calculated RPM metadata, as well as automatically generating a " +
+			"gzipped version of the group files, if required.",
+	},
+})
+
+type RpmRepositoryParams struct {
+	LocalRepositoryBaseParams
+	RootDepth               int    `hcl:"yum_root_depth" json:"yumRootDepth"`
+	CalculateYumMetadata    bool   `hcl:"calculate_yum_metadata" json:"calculateYumMetadata"`
+	EnableFileListsIndexing bool   `hcl:"enable_file_lists_indexing" json:"enableFileListsIndexing"`
+	GroupFileNames          string `hcl:"yum_group_file_names" json:"yumGroupFileNames"`
+}
 
-	type RpmLocalRepositoryParams struct {
-		LocalRepositoryBaseParams
-		RootDepth               int    `hcl:"yum_root_depth" json:"yumRootDepth"`
-		CalculateYumMetadata    bool   `hcl:"calculate_yum_metadata" json:"calculateYumMetadata"`
-		EnableFileListsIndexing bool   `hcl:"enable_file_lists_indexing" json:"enableFileListsIndexing"`
-		GroupFileNames          string `hcl:"yum_group_file_names" json:"yumGroupFileNames"`
+// unpackRpmRepository is shared by the local and federated RPM repositories
+func unpackRpmRepository(data *schema.ResourceData, rclass string) RpmRepositoryParams {
+	d := &ResourceData{ResourceData: data}
+	return RpmRepositoryParams{
+		LocalRepositoryBaseParams: unpackBaseRepo(rclass, data, "rpm"),
+		RootDepth:                 d.getInt("yum_root_depth", false),
+		CalculateYumMetadata:      d.getBool("calculate_yum_metadata", false),
+		EnableFileListsIndexing:   d.getBool("enable_file_lists_indexing", false),
+		GroupFileNames:            d.getString("yum_group_file_names", false),
 	}
+}
 
+func resourceArtifactoryLocalRpmRepository() *schema.Resource {
 	unPackLocalRpmRepository := func(data *schema.ResourceData) (interface{}, string, error) {
-		d := &ResourceData{ResourceData: data}
-		repo := RpmLocalRepositoryParams{
-			LocalRepositoryBaseParams: unpackBaseRepo("local", data, "rpm"),
-			RootDepth:                 d.getInt("yum_root_depth", false),
-			CalculateYumMetadata:      d.getBool("calculate_yum_metadata", false),
-			EnableFileListsIndexing:   d.getBool("enable_file_lists_indexing", false),
-			GroupFileNames:            d.getString("yum_group_file_names", false),
-		}
-
+		repo := unpackRpmRepository(data, "local")
 		return repo, repo.Id(), nil
 	}
 
-	return mkResourceSchema(rpmLocalSchema, inSchema(rpmLocalSchema), unPackLocalRpmRepository, func() interface{} {
-		return &RpmLocalRepositoryParams{
+	// index_on_create is an action of the local resource, not a repository setting, so it isn't part of the shared schema
+	skeema := mergeSchema(rpmLocalSchema, map[string]*schema.Schema{
+		"index_on_create": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "When set, the calculation of the RPM metadata is triggered once the repository is created, e.g. when " +
+				"artifacts are bulk imported into it. Failures are reported as warnings. Default value is 'false'.",
+		},
+	})
+
+	resource := mkResourceSchema(skeema, inSchema(rpmLocalSchema), unPackLocalRpmRepository, func() interface{} {
+		return &RpmRepositoryParams{
 			LocalRepositoryBaseParams: LocalRepositoryBaseParams{
 				PackageType: "rpm",
 				Rclass:      "local",
@@ -73,4 +94,33 @@ func resourceArtifactoryLocalRpmRepository() *schema.Resource {
 			GroupFileNames:          "",
 		}
 	})
+
+	create := resource.CreateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if diags.HasError() || !d.Get("index_on_create").(bool) {
+			return diags
+		}
+		return append(diags, calculateYumMetadata(m.(*resty.Client), d.Id())...)
+	}
+
+	return resource
+}
+
+// calculateYumMetadata schedules the asynchronous calculation of the RPM metadata of the repository. Like the npm
+// warmup this is best effort, the repository exists whatever happens here
+func calculateYumMetadata(client *resty.Client, repoKey string) diag.Diagnostics {
+	_, err := client.R().
+		SetPathParam("repoKey", repoKey).
+		SetQueryParam("async", "1").
+		AddRetryCondition(neverRetry).
+		Post("artifactory/api/yum/{repoKey}")
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("failed to calculate the RPM metadata of %s", repoKey),
+			Detail:   fmt.Sprintf("repository %s was created, but the metadata calculation could not be triggered: %s", repoKey, err),
+		}}
+	}
+	return nil
 }
@@ -3,6 +3,7 @@ package artifactory
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -21,8 +22,9 @@ func resourceArtifactoryPullReplication() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema:      mergeSchema(replicationSchemaCommon, replicationSchema),
-		Description: "Used for configuring pull replication on remote repos.",
+		Schema:        mergeSchema(replicationSchemaCommon, replicationSchema),
+		CustomizeDiff: syncStatisticsDiff,
+		Description:   "Used for configuring pull replication on remote repos.",
 	}
 }
 
@@ -40,10 +42,21 @@ func unpackPullReplication(s *schema.ResourceData) *ReplicationBody {
 	replicationConfig.SyncProperties = d.getBool("sync_properties", false)
 	replicationConfig.SyncStatistics = d.getBool("sync_statistics", false)
 	replicationConfig.PathPrefix = d.getString("path_prefix", false)
+	replicationConfig.Password = replicationPassword(s, "password")
 
 	return replicationConfig
 }
 
+// syncStatisticsDiff rejects sync_statistics without sync_properties. Artifactory silently ignores
+// statistics sync unless properties are synced as well, so catch it at plan time instead.
+func syncStatisticsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("sync_statistics").(bool) && !diff.Get("sync_properties").(bool) {
+		return fmt.Errorf("sync_statistics requires sync_properties. Set sync_properties = true to enable property sync")
+	}
+
+	return nil
+}
+
 func packPullReplicationBody(config PullReplication, d *schema.ResourceData) diag.Diagnostics {
 	setValue := mkLens(d)
 
@@ -54,6 +67,8 @@ func packPullReplicationBody(config PullReplication, d *schema.ResourceData) dia
 	setValue("enabled", config.Enabled)
 	setValue("sync_deletes", config.SyncDeletes)
 	setValue("sync_properties", config.SyncProperties)
+	// the password comes back scrambled, so the hash of the configured one is kept instead
+	setValue("password", replicationPasswordState(d, "password"))
 
 	errors := setValue("path_prefix", config.PathPrefix)
 
@@ -65,6 +80,9 @@ func packPullReplicationBody(config PullReplication, d *schema.ResourceData) dia
 }
 func resourcePullReplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	replicationConfig := unpackPullReplication(d)
+	if diags := checkReplicationRepoExists(m.(*resty.Client), replicationConfig.RepoKey); diags != nil {
+		return diags
+	}
 	// The password is sent clear
 	_, err := m.(*resty.Client).R().SetBody(replicationConfig).Put(replicationEndpoint + replicationConfig.RepoKey)
 	if err != nil {
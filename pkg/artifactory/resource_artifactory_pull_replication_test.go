@@ -1,11 +1,13 @@
 package artifactory
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -54,6 +56,54 @@ func TestInvalidCronPullReplication(t *testing.T) {
 	})
 }
 
+func TestInvalidSyncStatisticsPullReplication(t *testing.T) {
+	_, fqrn, name := mkNames("lib-local", "artifactory_pull_replication")
+	config := fmt.Sprintf(`
+		resource "artifactory_local_repository" "%s" {
+			key = "%s"
+			package_type = "maven"
+		}
+
+		resource "artifactory_pull_replication" "%s" {
+			repo_key = "${artifactory_local_repository.%s.key}"
+			cron_exp = "0 0 * * * ?"
+			url = "%s"
+			sync_properties = false
+			sync_statistics = true
+		}
+	`, name, name, name, name, os.Getenv("ARTIFACTORY_URL"))
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:      testAccCheckReplicationDestroy(fqrn),
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*sync_statistics requires sync_properties.*`),
+			},
+		},
+	})
+}
+
+func TestPullReplicationSendsPassword(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceArtifactoryPullReplication().Schema, map[string]interface{}{
+		"repo_key": "lib-remote",
+		"cron_exp": "0 0 * * * ?",
+		"url":      "http://localhost:8080/artifactory/lib-local",
+		"username": "admin",
+		"password": "password",
+	})
+
+	body, err := json.Marshal(unpackPullReplication(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`"password":"password"`).Match(body) {
+		t.Errorf("expected the configured password to be sent: %s", body)
+	}
+}
+
 func TestAccPullReplication_full(t *testing.T) {
 	_, fqrn, name := mkNames("lib-local", "artifactory_pull_replication")
 	config := mkTclForPullRepConfg(name, "0 0 * * * ?", os.Getenv("ARTIFACTORY_URL"))
@@ -3,6 +3,7 @@ package artifactory
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -14,7 +15,7 @@ import (
 
 type ReplicationBody struct {
 	Username               string `json:"username"`
-	Password               string `json:"password"`
+	Password               string `json:"password,omitempty"`
 	URL                    string `json:"url"`
 	CronExp                string `json:"cronExp"`
 	RepoKey                string `json:"repoKey"`
@@ -96,16 +97,20 @@ var pushReplicationSchema = map[string]*schema.Schema{
 		Optional: true,
 	},
 	"password": {
-		Type:      schema.TypeString,
-		Computed:  true,
-		Sensitive: true,
-		Description: "If a password is used to create the resource, it will be returned as encrypted and this will become the new state." +
-			"Practically speaking, what this means is that, the password can only be set, not gotten. ",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		StateFunc:        getMD5Hash,
+		DiffSuppressFunc: keepReplicationPasswordDiff,
+		Description: "Only a hash of the password is kept in the state. The password is only sent when it changes in the " +
+			"configuration, leaving it unchanged keeps the password already set on the target. The password can't be " +
+			"cleared: removing it from the configuration or setting it empty keeps the one already set as well.",
 	},
 	"enabled": {
-		Type:     schema.TypeBool,
-		Optional: true,
-		Computed: true,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "When set, this replication will be enabled when saved. Set it to false to pause the replication. Default value is 'true'.",
 	},
 	"sync_deletes": {
 		Type:     schema.TypeBool,
@@ -138,13 +143,21 @@ func resourceArtifactoryPushReplication() *schema.Resource {
 		CreateContext: resourcePushReplicationCreate,
 		ReadContext:   resourcePushReplicationRead,
 		UpdateContext: resourcePushReplicationUpdate,
-		DeleteContext: resourceReplicationDelete,
+		DeleteContext: resourcePushReplicationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: mergeSchema(pushReplicationSchemaCommon, pushRepMultipleSchema),
+		Schema: mergeSchema(pushReplicationSchemaCommon, pushRepMultipleSchema, map[string]*schema.Schema{
+			"delete_target_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When set, a final replication is run before the replication is deleted, so the targets don't keep stale " +
+					"data. Deleted artifacts are only removed from the targets of replications with sync_deletes set. Default value is 'false'.",
+			},
+		}),
 	}
 }
 
@@ -209,9 +222,7 @@ func unpackPushReplication(s *schema.ResourceData) UpdatePushReplication {
 				replication.Proxy = handleResetWithNonExistantValue(d, fmt.Sprintf("replications.%d.proxy", i))
 			}
 
-			if pass, ok := m["password"]; ok {
-				replication.Password = pass.(string)
-			}
+			replication.Password = replicationPassword(s, fmt.Sprintf("replications.%d.password", i))
 
 			pushReplication.Replications = append(pushReplication.Replications, replication)
 		}
@@ -230,13 +241,13 @@ func packPushReplication(pushReplication *GetPushReplication, d *schema.Resource
 
 	if pushReplication.Replications != nil {
 		var replications []map[string]interface{}
-		for _, repo := range pushReplication.Replications {
+		for i, repo := range pushReplication.Replications {
 			replication := make(map[string]interface{})
 
 			replication["url"] = repo.URL
 			replication["socket_timeout_millis"] = repo.SocketTimeoutMillis
 			replication["username"] = repo.Username
-			replication["password"] = repo.Password
+			replication["password"] = replicationPasswordState(d, fmt.Sprintf("replications.%d.password", i))
 			replication["enabled"] = repo.Enabled
 			replication["sync_deletes"] = repo.SyncDeletes
 			replication["sync_properties"] = repo.SyncProperties
@@ -257,6 +268,9 @@ func packPushReplication(pushReplication *GetPushReplication, d *schema.Resource
 
 func resourcePushReplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	pushReplication := unpackPushReplication(d)
+	if diags := checkReplicationRepoExists(m.(*resty.Client), pushReplication.RepoKey); diags != nil {
+		return diags
+	}
 
 	_, err := m.(*resty.Client).R().SetBody(pushReplication).Put("artifactory/api/replications/multiple/" + pushReplication.RepoKey)
 	if err != nil {
@@ -300,8 +314,24 @@ func resourcePushReplicationUpdate(ctx context.Context, d *schema.ResourceData,
 	return resourcePushReplicationRead(ctx, d, m)
 }
 
+func resourcePushReplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("delete_target_on_destroy").(bool) {
+		// runs every replication configured on the repository, before they go away with the config
+		resp, err := m.(*resty.Client).R().AddRetryCondition(neverRetry).Post("artifactory/api/replication/execute/" + d.Id())
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return diag.Errorf("failed to run the final replication of %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceReplicationDelete(ctx, d, m)
+}
+
 func resourceReplicationDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	_, err := m.(*resty.Client).R().Delete("artifactory/api/replications/" + d.Id())
+	resp, err := m.(*resty.Client).R().AddRetryCondition(neverRetry).Delete("artifactory/api/replications/" + d.Id())
+	// the replication, or the whole repository, is already gone
+	if err != nil && resp != nil && resp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
 	return diag.FromErr(err)
 }
 
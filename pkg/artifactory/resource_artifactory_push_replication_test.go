@@ -1,9 +1,14 @@
 package artifactory
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -81,6 +86,7 @@ func TestAccPushReplication_full(t *testing.T) {
 			repo_key = "${artifactory_local_repository.lib-local.key}"
 			cron_exp = "0 0 * * * ?"
 			enable_event_replication = true
+			delete_target_on_destroy = true
 
 			replications {
 				url = "%s"
@@ -114,12 +120,147 @@ func TestAccPushReplication_full(t *testing.T) {
 					resource.TestCheckResourceAttr("artifactory_push_replication.lib-local", "enable_event_replication", "true"),
 					resource.TestCheckResourceAttr("artifactory_push_replication.lib-local", "replications.#", "1"),
 					resource.TestCheckResourceAttr("artifactory_push_replication.lib-local", "replications.0.proxy", testProxy),
+					resource.TestCheckResourceAttr("artifactory_push_replication.lib-local", "delete_target_on_destroy", "true"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccPushReplicationMissingRepo(t *testing.T) {
+	_, _, name := mkNames("missing-local", "artifactory_push_replication")
+	config := fmt.Sprintf(`
+		resource "artifactory_push_replication" "%[1]s" {
+			repo_key = "%[1]s"
+			cron_exp = "0 0 * * * ?"
+
+			replications {
+				url      = "%[2]s"
+				username = "%[3]s"
+			}
+		}
+	`, name, os.Getenv("ARTIFACTORY_URL"), os.Getenv("ARTIFACTORY_USERNAME"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(fmt.Sprintf("repository %s does not exist; create it first \\(use depends_on\\)", name)),
+			},
+		},
+	})
+}
+
+func TestReplicationEmptyPasswordIsNotSent(t *testing.T) {
+	body, err := json.Marshal(ReplicationBody{URL: "http://localhost:8080", Username: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regexp.MustCompile(`"password"`).Match(body) {
+		t.Errorf("an empty password would overwrite the one set on the target: %s", body)
+	}
+
+	hashed := getMD5Hash("password")
+	if !keepReplicationPasswordDiff("replications.0.password", hashed, "", nil) {
+		t.Error("removing the password from the configuration should not produce a diff")
+	}
+	if keepReplicationPasswordDiff("replications.0.password", hashed, getMD5Hash("other password"), nil) {
+		t.Error("a changed password should produce a diff")
+	}
+}
+
+func TestPushReplicationUpdateKeepsPassword(t *testing.T) {
+	var posted []map[string]interface{}
+	replications := []getReplicationBody{{
+		ReplicationBody: ReplicationBody{
+			URL:      "http://localhost:8080/artifactory/lib-remote",
+			Username: "admin",
+			Password: "JE2fNsEThvb1buiH7h7S2RDsGWSdp2EcuG9Pky5AFyRMwE4UzG",
+			CronExp:  "0 0 * * * ?",
+			RepoKey:  "lib-local",
+			Enabled:  true,
+		},
+	}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/api/replications/") && r.Method == http.MethodPost:
+			body := map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			posted = append(posted, body)
+			replications[0].CronExp = body["cronExp"].(string)
+		case strings.Contains(r.URL.Path, "/api/replications/"):
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(replications)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := resourceArtifactoryPushReplication()
+	config := func(cronExp string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"repo_key": "lib-local",
+			"cron_exp": cronExp,
+			"replications": []interface{}{map[string]interface{}{
+				"url":      "http://localhost:8080/artifactory/lib-remote",
+				"username": "admin",
+				"password": "password",
+			}},
+		})
+	}
+	state := &terraform.InstanceState{
+		ID: "lib-local",
+		Attributes: map[string]string{
+			"id":                                   "lib-local",
+			"repo_key":                             "lib-local",
+			"cron_exp":                             "0 0 * * * ?",
+			"enable_event_replication":             "false",
+			"replications.#":                       "1",
+			"replications.0.url":                   "http://localhost:8080/artifactory/lib-remote",
+			"replications.0.username":              "admin",
+			"replications.0.password":              getMD5Hash("password"),
+			"replications.0.enabled":               "true",
+			"replications.0.socket_timeout_millis": "0",
+			"replications.0.sync_deletes":          "false",
+			"replications.0.sync_properties":       "false",
+			"replications.0.sync_statistics":       "false",
+		},
+	}
+
+	diff, err := res.Diff(context.Background(), state, config("0 30 * * * ?"), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := diff.Attributes["replications.0.password"]; ok {
+		t.Fatalf("an unchanged password should not produce a diff: %v", diff.Attributes["replications.0.password"])
+	}
+	updated, diags := res.Apply(context.Background(), state, diff, client.SetRetryCount(0))
+	if diags.HasError() {
+		t.Fatalf("failed to update the replication: %v", diags)
+	}
+	if len(posted) != 1 {
+		t.Fatalf("expected one update, got %v", posted)
+	}
+	for _, replication := range posted[0]["replications"].([]interface{}) {
+		if password, ok := replication.(map[string]interface{})["password"]; ok {
+			t.Errorf("the hash kept in the state would overwrite the password set on the target: %v", password)
+		}
+	}
+	if expected := getMD5Hash("password"); updated.Attributes["replications.0.password"] != expected {
+		t.Errorf("expected the hash of the configured password to be kept, got %s", updated.Attributes["replications.0.password"])
+	}
+}
+
 func testAccCheckPushReplicationDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -0,0 +1,49 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var bowerRemoteSchema = mergeSchema(baseRemoteSchema, vcsGitSchema, map[string]*schema.Schema{
+	"bower_registry_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "https://registry.bower.io",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		Description:      `(Optional) Proxy remote Bower repository. Default value is "https://registry.bower.io".`,
+	},
+}, repoLayoutRefSchema("remote", "bower"))
+
+type BowerRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	BowerRegistryUrl  string `hcl:"bower_registry_url" json:"bowerRegistryUrl"`
+	VcsGitProvider    string `hcl:"vcs_git_provider" json:"vcsGitProvider"`
+	VcsGitDownloadUrl string `hcl:"vcs_git_download_url" json:"vcsGitDownloadUrl"`
+}
+
+func resourceArtifactoryRemoteBowerRepository() *schema.Resource {
+	resource := mkResourceSchema(bowerRemoteSchema, defaultPacker, unpackBowerRemoteRepo, func() interface{} {
+		return &BowerRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:      "remote",
+				PackageType: "bower",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, vcsGitDownloadUrlDiff)
+
+	return resource
+}
+
+func unpackBowerRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := BowerRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "bower"),
+		BowerRegistryUrl:           d.getString("bower_registry_url", false),
+		VcsGitProvider:             d.getString("vcs_git_provider", false),
+		VcsGitDownloadUrl:          d.getString("vcs_git_download_url", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -1,16 +1,36 @@
 package artifactory
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const cargoDefaultGitRegistryUrl = "https://github.com/rust-lang/crates.io-index"
+
 var cargoRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
 	"git_registry_url": {
 		Type:         schema.TypeString,
-		Required:     true,
+		Optional:     true,
 		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-		Description:  `This is the index url, expected to be a git repository. for remote artifactory use "arturl/git/repokey.git"`,
+		// leaving it out keeps whatever Artifactory holds: the crates.io index, or nothing that matters with the sparse index
+		DiffSuppressFunc: func(_, old, new string, d *schema.ResourceData) bool {
+			if new != "" {
+				return false
+			}
+			return d.Get("enable_sparse_index").(bool) || old == cargoDefaultGitRegistryUrl
+		},
+		Description: `This is the index url, expected to be a git repository. for remote artifactory use "arturl/git/repokey.git". ` +
+			`Only used with the git index, defaults to "` + cargoDefaultGitRegistryUrl + `" when 'enable_sparse_index' is off.`,
+	},
+	"enable_sparse_index": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Enable internal index support based on Cargo sparse index specifications, instead of the default git index. Default value is 'false'.",
 	},
 	"anonymous_access": {
 		Type:     schema.TypeBool,
@@ -22,12 +42,13 @@ var cargoRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
 
 type CargoRemoteRepo struct {
 	RemoteRepositoryBaseParams
-	RegistryUrl     string `hcl:"git_registry_url" json:"gitRegistryUrl"`
-	AnonymousAccess bool   `hcl:"anonymous_access" json:"cargoAnonymousAccess"`
+	RegistryUrl       string `hcl:"git_registry_url" json:"gitRegistryUrl"`
+	AnonymousAccess   bool   `hcl:"anonymous_access" json:"cargoAnonymousAccess"`
+	EnableSparseIndex bool   `hcl:"enable_sparse_index" json:"cargoInternalIndex"`
 }
 
 func resourceArtifactoryRemoteCargoRepository() *schema.Resource {
-	return mkResourceSchema(cargoRemoteSchema, defaultPacker, unpackCargoRemoteRepo, func() interface{} {
+	resource := mkResourceSchema(cargoRemoteSchema, defaultPacker, unpackCargoRemoteRepo, func() interface{} {
 		return &CargoRemoteRepo{
 			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
 				Rclass:      "remote",
@@ -35,6 +56,19 @@ func resourceArtifactoryRemoteCargoRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, cargoIndexDiff)
+	return resource
+}
+
+// cargoIndexDiff rejects a git index configured next to the sparse index, Artifactory silently ignores it then
+func cargoIndexDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get("enable_sparse_index").(bool) || !diff.HasChange("git_registry_url") {
+		return nil
+	}
+	if registryUrl := diff.Get("git_registry_url").(string); registryUrl != "" {
+		return fmt.Errorf("git_registry_url %s is not used with enable_sparse_index, remove it or turn the sparse index off", registryUrl)
+	}
+	return nil
 }
 
 func unpackCargoRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
@@ -43,6 +77,10 @@ func unpackCargoRemoteRepo(s *schema.ResourceData) (interface{}, string, error)
 		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "cargo"),
 		RegistryUrl:                d.getString("git_registry_url", false),
 		AnonymousAccess:            d.getBool("anonymous_access", false),
+		EnableSparseIndex:          d.getBool("enable_sparse_index", false),
+	}
+	if !repo.EnableSparseIndex && repo.RegistryUrl == "" {
+		repo.RegistryUrl = cargoDefaultGitRegistryUrl
 	}
 	return repo, repo.Id(), nil
 }
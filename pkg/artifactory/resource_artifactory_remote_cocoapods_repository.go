@@ -0,0 +1,51 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var cocoapodsRemoteSchema = mergeSchema(baseRemoteSchema, vcsGitSchema, map[string]*schema.Schema{
+	"pods_specs_repo_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "https://github.com/CocoaPods/Specs",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		Description: `(Optional) Proxy remote CocoaPods Specs repositories. A private Specs repository is accessed with ` +
+			`the username and password of the repository. Default value is "https://github.com/CocoaPods/Specs".`,
+	},
+}, repoLayoutRefSchema("remote", "cocoapods"))
+
+type CocoapodsRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	PodsSpecsRepoUrl  string `hcl:"pods_specs_repo_url" json:"podsSpecsRepoUrl"`
+	VcsGitProvider    string `hcl:"vcs_git_provider" json:"vcsGitProvider"`
+	VcsGitDownloadUrl string `hcl:"vcs_git_download_url" json:"vcsGitDownloadUrl"`
+}
+
+func resourceArtifactoryRemoteCocoapodsRepository() *schema.Resource {
+	resource := mkResourceSchema(cocoapodsRemoteSchema, defaultPacker, unpackCocoapodsRemoteRepo, func() interface{} {
+		return &CocoapodsRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "cocoapods",
+				RepoLayoutRef: defaultRepoLayoutRefs["cocoapods"],
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, vcsGitDownloadUrlDiff)
+
+	return resource
+}
+
+func unpackCocoapodsRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := CocoapodsRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "cocoapods"),
+		PodsSpecsRepoUrl:           d.getString("pods_specs_repo_url", false),
+		VcsGitProvider:             d.getString("vcs_git_provider", false),
+		VcsGitDownloadUrl:          d.getString("vcs_git_download_url", false),
+	}
+	return repo, repo.Id(), nil
+}
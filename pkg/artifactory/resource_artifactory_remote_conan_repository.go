@@ -0,0 +1,41 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var conanRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
+	"url": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "https://center.conan.io",
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "The remote repo URL. Default value is 'https://center.conan.io'.",
+	},
+}, conanForceAuth.Schema, repoLayoutRefSchema("remote", "conan"))
+
+type ConanRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	ForceConanAuthentication bool `hcl:"force_conan_authentication" json:"forceConanAuthentication"`
+}
+
+func resourceArtifactoryRemoteConanRepository() *schema.Resource {
+	return mkResourceSchema(conanRemoteSchema, defaultPacker, unpackConanRemoteRepo, func() interface{} {
+		return &ConanRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:      "remote",
+				PackageType: "conan",
+			},
+		}
+	})
+}
+
+func unpackConanRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := ConanRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "conan"),
+		ForceConanAuthentication:   d.getBool(conanForceAuth.Attribute, false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,54 @@
+package artifactory
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const condaRemoteUrlDefault = "https://repo.anaconda.com/pkgs/main"
+
+var condaRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "conda"), map[string]*schema.Schema{
+	"url": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      condaRemoteUrlDefault,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		// a channel base works the same with or without the trailing slash
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+		},
+		Description: "The base URL of the channel to proxy, e.g. 'https://conda.anaconda.org/conda-forge'. " +
+			"Each channel needs a remote repository of its own. Default value is '" + condaRemoteUrlDefault + "'.",
+	},
+	"list_remote_folder_items": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Lists the items of remote folders in simple and list browsing, so the platforms of the channel can be browsed. Default value is 'true'.",
+	},
+})
+
+type CondaRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteCondaRepository() *schema.Resource {
+	return mkResourceSchema(condaRemoteSchema, defaultPacker, unpackCondaRemoteRepo, func() interface{} {
+		return &CondaRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "conda",
+				RepoLayoutRef: defaultRepoLayoutRefs["conda"],
+			},
+		}
+	})
+}
+
+func unpackCondaRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := CondaRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "conda"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,30 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var cranRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "cran"))
+
+type CranRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteCranRepository() *schema.Resource {
+	return mkResourceSchema(cranRemoteSchema, defaultPacker, unpackCranRemoteRepo, func() interface{} {
+		return &CranRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "cran",
+				RepoLayoutRef: defaultRepoLayoutRefs["cran"],
+			},
+		}
+	})
+}
+
+func unpackCranRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := CranRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "cran"),
+	}
+	return repo, repo.Id(), nil
+}
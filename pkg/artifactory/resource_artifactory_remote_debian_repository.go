@@ -0,0 +1,69 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var debianRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "debian"), map[string]*schema.Schema{
+	"url": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.All(validation.IsURLWithHTTPorHTTPS, validateDebianArchiveUrl),
+		Description:  "The root of the Debian archive to proxy, holding its 'dists' and 'pool' directories, e.g. 'http://archive.ubuntu.com/ubuntu/'.",
+	},
+	"trivial_layout": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set, the remote archive is expected to use the trivial layout, without the 'dists' directory. Default value is 'false'.",
+	},
+})
+
+// validateDebianArchiveUrl rejects the url of a distribution or of the package pool instead of the root of the archive,
+// apt asks for them relative to the root through the repository
+func validateDebianArchiveUrl(value interface{}, key string) ([]string, []error) {
+	archiveUrl, err := url.Parse(value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	segments := strings.Split(archiveUrl.Path, "/")
+	for i, segment := range segments {
+		if segment == "dists" || segment == "pool" {
+			root := *archiveUrl
+			root.Path = strings.Join(segments[:i], "/") + "/"
+			return nil, []error{fmt.Errorf("%s must be the root of the Debian archive, e.g. %s, not its %s directory", key, root.String(), segment)}
+		}
+	}
+	return nil, nil
+}
+
+type DebianRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	TrivialLayout bool `hcl:"trivial_layout" json:"debianTrivialLayout"`
+}
+
+func resourceArtifactoryRemoteDebianRepository() *schema.Resource {
+	return mkResourceSchema(debianRemoteSchema, defaultPacker, unpackDebianRemoteRepo, func() interface{} {
+		return &DebianRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "debian",
+				RepoLayoutRef: defaultRepoLayoutRefs["debian"],
+			},
+		}
+	})
+}
+
+func unpackDebianRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := DebianRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "debian"),
+		TrivialLayout:              d.getBool("trivial_layout", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -1,6 +1,10 @@
 package artifactory
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -44,7 +48,7 @@ func resourceArtifactoryRemoteDockerRepository() *schema.Resource {
 				"By default, this is set to '**', which means that remote modules may be downloaded from any external VCS source.",
 		},
 	})
-	return mkResourceSchema(dockerRemoteSchema, defaultPacker, unpackDockerRemoteRepo, func() interface{} {
+	resource := mkResourceSchema(dockerRemoteSchema, defaultPacker, unpackDockerRemoteRepo, func() interface{} {
 		return &DockerRemoteRepository{
 			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
 				Rclass:      "remote",
@@ -52,6 +56,23 @@ func resourceArtifactoryRemoteDockerRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, dockerRemoteCredentialsDiff)
+
+	return resource
+}
+
+// dockerRemoteCredentialsDiff makes sure a token for a private upstream registry comes with its username: registries
+// such as GHCR or ECR only exchange the password (token) for a bearer token along with the username
+func dockerRemoteCredentialsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// credentials coming from other resources are only known at apply time
+	if !diff.NewValueKnown("username") || !diff.NewValueKnown("password") {
+		return nil
+	}
+	if diff.Get("password").(string) != "" && diff.Get("username").(string) == "" {
+		return fmt.Errorf("username must be set along with password to authenticate against the upstream registry, e.g. the GitHub user for a GHCR token or 'AWS' for an ECR token")
+	}
+
+	return nil
 }
 
 func unpackDockerRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
@@ -0,0 +1,45 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var genericRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
+	"propagate_query_params": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set, if query params are included in the request to Artifactory, they will be passed on to the remote repository. Default value is 'false'.",
+	},
+	"query_params": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Custom HTTP query parameters that will be automatically included in all remote resource requests. " +
+			"For example: 'param1=val1&param2=val2&param3=val3'",
+	},
+})
+
+type GenericRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	QueryParams string `hcl:"query_params" json:"queryParams"`
+}
+
+func resourceArtifactoryRemoteGenericRepository() *schema.Resource {
+	return mkResourceSchema(genericRemoteSchema, defaultPacker, unpackGenericRemoteRepo, func() interface{} {
+		return &GenericRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:      "remote",
+				PackageType: "generic",
+			},
+		}
+	})
+}
+
+func unpackGenericRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := GenericRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "generic"),
+		QueryParams:                d.getString("query_params", false),
+	}
+	return repo, repo.Id(), nil
+}
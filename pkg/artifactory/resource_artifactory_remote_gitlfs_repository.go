@@ -0,0 +1,30 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitlfsRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "gitlfs"))
+
+type GitLfsRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteGitLfsRepository() *schema.Resource {
+	return mkResourceSchema(gitlfsRemoteSchema, defaultPacker, unpackGitLfsRemoteRepo, func() interface{} {
+		return &GitLfsRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "gitlfs",
+				RepoLayoutRef: defaultRepoLayoutRefs["gitlfs"],
+			},
+		}
+	})
+}
+
+func unpackGitLfsRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := GitLfsRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "gitlfs"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,46 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var goVcsGitProvidersSupported = []string{"ARTIFACTORY", "BITBUCKET", "GITHUB", "GITLAB", "STASH"}
+
+var goRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
+	"vcs_git_provider": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "ARTIFACTORY",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(goVcsGitProvidersSupported, false)),
+		Description: `(Optional) With "ARTIFACTORY", the modules are resolved with the GOPROXY protocol from the url, ` +
+			`e.g. https://proxy.golang.org or the Go API of another Artifactory instance, before falling back to their VCS. ` +
+			`The other providers fetch the modules straight from the Git provider. Default value is "ARTIFACTORY".`,
+	},
+}, repoLayoutRefSchema("remote", "go"))
+
+type GoRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	VcsGitProvider string `hcl:"vcs_git_provider" json:"vcsGitProvider"`
+}
+
+func resourceArtifactoryRemoteGoRepository() *schema.Resource {
+	return mkResourceSchema(goRemoteSchema, defaultPacker, unpackGoRemoteRepo, func() interface{} {
+		return &GoRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "go",
+				RepoLayoutRef: defaultRepoLayoutRefs["go"],
+			},
+		}
+	})
+}
+
+func unpackGoRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := GoRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "go"),
+		VcsGitProvider:             d.getString("vcs_git_provider", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,40 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// helmOciRemoteSchema has no helm_charts_base_url: chart URLs are only translated in the index.yaml of the classic
+// Helm repositories, which OCI registries don't have
+var helmOciRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
+	"external_dependencies_enabled":  helmRemoteSchema["external_dependencies_enabled"],
+	"external_dependencies_patterns": helmRemoteSchema["external_dependencies_patterns"],
+}, repoLayoutRefSchema("remote", "helmoci"))
+
+type HelmOciRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	ExternalDependenciesEnabled  bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled"`
+	ExternalDependenciesPatterns []string `hcl:"external_dependencies_patterns" json:"externalDependenciesPatterns"`
+}
+
+func resourceArtifactoryRemoteHelmOciRepository() *schema.Resource {
+	return mkResourceSchema(helmOciRemoteSchema, defaultPacker, unpackHelmOciRemoteRepo, func() interface{} {
+		return &HelmOciRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "helmoci",
+				RepoLayoutRef: defaultRepoLayoutRefs["helmoci"],
+			},
+		}
+	})
+}
+
+func unpackHelmOciRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := HelmOciRemoteRepo{
+		RemoteRepositoryBaseParams:   unpackBaseRemoteRepo(s, "helmoci"),
+		ExternalDependenciesEnabled:  d.getBool("external_dependencies_enabled", false),
+		ExternalDependenciesPatterns: d.getList("external_dependencies_patterns"),
+	}
+	return repo, repo.Id(), nil
+}
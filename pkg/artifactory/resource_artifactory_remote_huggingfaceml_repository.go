@@ -0,0 +1,65 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var huggingfacemlRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "huggingfaceml"), map[string]*schema.Schema{
+	"url": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.All(validation.IsURLWithHTTPorHTTPS, validateHuggingFaceUrl),
+		Description:  "The root of the Hugging Face hub to proxy, e.g. 'https://huggingface.co'.",
+	},
+	"password": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		StateFunc:   getMD5Hash,
+		Description: "A Hugging Face access token, required to download private and gated models. Artifactory sends it to the hub as a bearer token, username can be left empty.",
+	},
+})
+
+// validateHuggingFaceUrl rejects the url of a model or dataset, the client asks for them by name through the repository
+func validateHuggingFaceUrl(value interface{}, key string) ([]string, []error) {
+	hubUrl, err := url.Parse(value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	if path := strings.Trim(hubUrl.Path, "/"); path != "" {
+		return nil, []error{fmt.Errorf("%s must be the root of the Hugging Face hub, e.g. %s://%s, not the model or dataset %s",
+			key, hubUrl.Scheme, hubUrl.Host, path)}
+	}
+	return nil, nil
+}
+
+type HuggingFaceMlRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+// Artifactory never returns the access token, the hash in the state is kept instead of being blanked
+var huggingFaceMlRemotePacker = universalPack(ignoreHclPredicate("class", "rclass", "password"))
+
+func resourceArtifactoryRemoteHuggingFaceMlRepository() *schema.Resource {
+	return mkResourceSchema(huggingfacemlRemoteSchema, huggingFaceMlRemotePacker, unpackHuggingFaceMlRemoteRepo, func() interface{} {
+		return &HuggingFaceMlRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "huggingfaceml",
+				RepoLayoutRef: defaultRepoLayoutRefs["huggingfaceml"],
+			},
+		}
+	})
+}
+
+func unpackHuggingFaceMlRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := HuggingFaceMlRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "huggingfaceml"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -1,6 +1,12 @@
 package artifactory
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -55,7 +61,7 @@ func resourceArtifactoryRemoteJavaRepository(repoType string, suppressPom bool)
 			Default:     false,
 			Description: `(Optional) Reject the caching of jar files that are found to be invalid. For example, pseudo jars retrieved behind a "captive portal". Default value is 'false'.`,
 		},
-	})
+	}, repoLayoutRefSchema("remote", repoType))
 
 	type JavaRemoteRepo struct {
 		RemoteRepositoryBaseParams
@@ -82,13 +88,35 @@ func resourceArtifactoryRemoteJavaRepository(repoType string, suppressPom bool)
 		}
 		return repo, repo.Id(), nil
 	}
-	return mkResourceSchema(javaRemoteSchema, defaultPacker, unpackJavaRemoteRepo, func() interface{} {
+	resource := mkResourceSchema(javaRemoteSchema, defaultPacker, unpackJavaRemoteRepo, func() interface{} {
 		return &JavaRemoteRepo{
 			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
-				Rclass:      "remote",
-				PackageType: repoType,
+				Rclass:        "remote",
+				PackageType:   repoType,
+				RepoLayoutRef: defaultRepoLayoutRefs[repoType],
 			},
 			SuppressPomConsistencyChecks: suppressPom,
 		}
 	})
+	if repoType == "gradle" {
+		resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, gradlePluginPortalUrlDiff)
+	}
+
+	return resource
+}
+
+const gradlePluginPortalHost = "plugins.gradle.org"
+
+// gradlePluginPortalUrlDiff catches remotes pointed at the Gradle Plugin Portal site instead of the Maven repository
+// it serves the plugins and their markers from, which resolves nothing. The portal uses the maven-2-default layout too
+func gradlePluginPortalUrlDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	remoteUrl, err := url.Parse(diff.Get("url").(string))
+	if err != nil || remoteUrl.Host != gradlePluginPortalHost {
+		return nil
+	}
+	if path := strings.Trim(remoteUrl.Path, "/"); path != "m2" {
+		return fmt.Errorf("url %s is not the Maven repository of the Gradle Plugin Portal, use https://%s/m2/ instead",
+			remoteUrl, gradlePluginPortalHost)
+	}
+	return nil
 }
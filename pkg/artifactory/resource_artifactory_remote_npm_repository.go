@@ -1,10 +1,16 @@
 package artifactory
 
 import (
-	"sort"
+	"context"
+	"fmt"
+	"net/url"
 	"strings"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceArtifactoryRemoteNpmRepository() *schema.Resource {
@@ -14,11 +20,17 @@ func resourceArtifactoryRemoteNpmRepository() *schema.Resource {
 			Type:             schema.TypeString,
 			Optional:         true,
 			ValidateDiagFunc: commaSeperatedList,
-			StateFunc: func(thing interface{}) string {
-				fields := strings.Fields(thing.(string))
-				sort.Strings(fields)
-				return strings.Join(fields, ",")
+			StateFunc:        normalizeCommaSeperatedList,
+		},
+		"warmup_packages": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			Description: "Packages, e.g. 'lodash' or '@types/node', whose metadata is requested through the repository once it " +
+				"is created, so the first resolution doesn't have to wait for the remote registry. Failures are reported as warnings.",
 		},
 	})
 	type NpmRemoteRepository struct {
@@ -34,7 +46,7 @@ func resourceArtifactoryRemoteNpmRepository() *schema.Resource {
 		return repo, repo.Id(), nil
 	}
 
-	return mkResourceSchema(npmRemoteSchema, inSchema(npmRemoteSchema), unpack, func() interface{} {
+	resource := mkResourceSchema(npmRemoteSchema, inSchema(npmRemoteSchema), unpack, func() interface{} {
 		return &NpmRemoteRepository{
 			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
 				Rclass:              "remote",
@@ -44,4 +56,60 @@ func resourceArtifactoryRemoteNpmRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, npmRemoteScopeDiff)
+
+	create := resource.CreateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, warmupNpmPackages(m.(*resty.Client), d.Id(), d.Get("warmup_packages").([]interface{}))...)
+	}
+
+	return resource
+}
+
+// warmupNpmPackages requests the metadata of the packages through the remote repository, so Artifactory caches it. Like
+// the rest of the warmup this is best effort, the repository exists whatever happens here
+func warmupNpmPackages(client *resty.Client, repoKey string, packages []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, name := range packages {
+		// scoped packages are requested as '@scope%2fname', the path param escapes the slash
+		_, err := client.R().
+			SetPathParams(map[string]string{
+				"repoKey": repoKey,
+				"package": name.(string),
+			}).
+			AddRetryCondition(neverRetry).
+			Get("artifactory/api/npm/{repoKey}/{package}")
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("failed to warm up npm package %s", name),
+				Detail:   fmt.Sprintf("repository %s was created, but the package could not be cached: %s", repoKey, err),
+			})
+		}
+	}
+	return diags
+}
+
+// npmRemoteScopeDiff catches the mistakes made when proxying a registry of scoped packages. The url has to be the root
+// of the registry, since the npm client adds the scope to every request ('@scope%2fname'), and the mismatching mime
+// types override list only applies while block_mismatching_mime_types is on
+func npmRemoteScopeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if registryUrl, err := url.Parse(diff.Get("url").(string)); err == nil {
+		for _, segment := range strings.Split(registryUrl.Path, "/") {
+			if strings.HasPrefix(segment, "@") {
+				return fmt.Errorf("url %s must point to the root of the npm registry, not to the scope %s. The npm client adds the scope to the package requests", registryUrl, segment)
+			}
+		}
+	}
+
+	block, ok := diff.GetOkExists("block_mismatching_mime_types")
+	if diff.Get("mismatching_mime_types_override_list").(string) != "" && ok && !block.(bool) {
+		return fmt.Errorf("mismatching_mime_types_override_list has no effect unless block_mismatching_mime_types is true")
+	}
+
+	return nil
 }
@@ -0,0 +1,144 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	nugetV2FeedContextPath     = "api/v2"
+	nugetV2DownloadContextPath = "api/v2/package"
+)
+
+var nugetRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
+	"feed_context_path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "When proxying a remote Artifactory instance, the context path of the NuGet feed. Default value is 'api/v2', or empty when url points at a v3 feed.",
+	},
+	"download_context_path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The context path prefix through which NuGet downloads are served. Default value is 'api/v2/package', or empty when url points at a v3 feed.",
+	},
+	"v3_feed_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		Description:      "The URL of the NuGet v3 service index, e.g. 'https://api.nuget.org/v3/index.json'. Set automatically when url points at a v3 feed.",
+	},
+}, nugetForceAuth.Schema, repoLayoutRefSchema("remote", "nuget"))
+
+type NugetRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	FeedContextPath          string `hcl:"feed_context_path" json:"feedContextPath"`
+	DownloadContextPath      string `hcl:"download_context_path" json:"downloadContextPath"`
+	V3FeedUrl                string `hcl:"v3_feed_url" json:"v3FeedUrl"`
+	ForceNugetAuthentication bool   `hcl:"force_nuget_authentication" json:"forceNugetAuthentication"`
+}
+
+func resourceArtifactoryRemoteNugetRepository() *schema.Resource {
+	resource := mkResourceSchema(nugetRemoteSchema, defaultPacker, unpackNugetRemoteRepo, func() interface{} {
+		return &NugetRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "nuget",
+				RepoLayoutRef: defaultRepoLayoutRefs["nuget"],
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, nugetV3FeedDiff)
+	resource.CreateContext = withApplyWarning(resource.CreateContext, nugetFeedContextPathWarning)
+	resource.UpdateContext = withApplyWarning(resource.UpdateContext, nugetFeedContextPathWarning)
+
+	return resource
+}
+
+// isNugetV3Index tells whether the url is a NuGet v3 service index rather than a v2 feed
+func isNugetV3Index(url string) bool {
+	return strings.HasSuffix(strings.TrimRight(url, "/"), "/index.json")
+}
+
+// nugetFeedDefaults returns the feed settings the UI derives from the url: for a v3 service index v3_feed_url is set to
+// it and the v2 context paths, which NuGet clients would otherwise be sent to, are emptied
+func nugetFeedDefaults(url string) map[string]string {
+	if isNugetV3Index(url) {
+		return map[string]string{"feed_context_path": "", "download_context_path": "", "v3_feed_url": url}
+	}
+	return map[string]string{"feed_context_path": nugetV2FeedContextPath, "download_context_path": nugetV2DownloadContextPath, "v3_feed_url": ""}
+}
+
+// nugetV3FeedDiff does what the UI does when the url changes and sets the feed settings derived from it. Values set
+// explicitly are kept. CustomizeDiff can't see the configuration with the SDK this provider is built on, so an existing
+// value is taken as set explicitly when it changes or differs from the one derived from the previous url
+func nugetV3FeedDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange("url") {
+		return nil
+	}
+
+	oldUrl, newUrl := diff.GetChange("url")
+	oldDefaults := nugetFeedDefaults(oldUrl.(string))
+	for key, value := range nugetFeedDefaults(newUrl.(string)) {
+		if diff.HasChange(key) || (diff.Id() != "" && diff.Get(key).(string) != oldDefaults[key]) {
+			continue
+		}
+		if err := diff.SetNew(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nugetFeedContextPathWarning warns about a feed_context_path alongside a v3 service index. Artifactory accepts it but
+// NuGet clients are sent to the v2 feed and fail with a 404
+func nugetFeedContextPathWarning(_ *resty.Client, d *schema.ResourceData) diag.Diagnostics {
+	url := d.Get("url").(string)
+	if feedContextPath := d.Get("feed_context_path").(string); isNugetV3Index(url) && feedContextPath != "" {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("feed_context_path of %s is only used by v2 feeds", d.Get("key")),
+			Detail:        fmt.Sprintf("url %s is a NuGet v3 service index, feed_context_path %s would make clients fail with a 404.", url, feedContextPath),
+			AttributePath: cty.GetAttrPath("feed_context_path"),
+		}}
+	}
+
+	return nil
+}
+
+func unpackNugetRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := NugetRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "nuget"),
+		FeedContextPath:            d.getString("feed_context_path", false),
+		DownloadContextPath:        d.getString("download_context_path", false),
+		V3FeedUrl:                  d.getString("v3_feed_url", false),
+		ForceNugetAuthentication:   d.getBool(nugetForceAuth.Attribute, false),
+	}
+
+	if isNugetV3Index(repo.Url) {
+		if repo.V3FeedUrl == "" {
+			repo.V3FeedUrl = repo.Url
+		}
+	} else {
+		if repo.FeedContextPath == "" {
+			repo.FeedContextPath = nugetV2FeedContextPath
+		}
+		if repo.DownloadContextPath == "" {
+			repo.DownloadContextPath = nugetV2DownloadContextPath
+		}
+	}
+
+	return repo, repo.Id(), nil
+}
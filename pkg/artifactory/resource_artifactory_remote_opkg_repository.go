@@ -0,0 +1,66 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// opkgIndexFiles are the files of an opkg feed index, the repository points to the feed directory holding them
+var opkgIndexFiles = []string{
+	"Packages",
+	"Packages.gz",
+	"Packages.sig",
+	"Packages.stamps",
+}
+
+var opkgRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "opkg"), map[string]*schema.Schema{
+	"url": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.All(validation.IsURLWithHTTPorHTTPS, validateOpkgFeedUrl),
+		Description: "The URL of the feed, the directory holding its Packages index, e.g. 'https://downloads.openwrt.org/releases/22.03.5/packages/x86_64/base/'. " +
+			"Feeds with their index at another path are proxied with one repository per path.",
+	},
+})
+
+// validateOpkgFeedUrl rejects the url of the index of a feed instead of the feed itself
+func validateOpkgFeedUrl(value interface{}, key string) ([]string, []error) {
+	feedUrl, err := url.Parse(value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	file := path.Base(feedUrl.Path)
+	for _, indexFile := range opkgIndexFiles {
+		if file == indexFile {
+			return nil, []error{fmt.Errorf("%s must be the feed holding %s, e.g. %s", key, file, feedUrl.ResolveReference(&url.URL{Path: "."}))}
+		}
+	}
+	return nil, nil
+}
+
+type OpkgRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteOpkgRepository() *schema.Resource {
+	return mkResourceSchema(opkgRemoteSchema, defaultPacker, unpackOpkgRemoteRepo, func() interface{} {
+		return &OpkgRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "opkg",
+				RepoLayoutRef: defaultRepoLayoutRefs["opkg"],
+			},
+		}
+	})
+}
+
+func unpackOpkgRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := OpkgRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "opkg"),
+	}
+	return repo, repo.Id(), nil
+}
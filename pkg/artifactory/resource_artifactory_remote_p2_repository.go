@@ -0,0 +1,77 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// p2MetadataFiles are the files describing a simple or composite update site, the repository points to the directory holding them
+var p2MetadataFiles = []string{
+	"compositeContent.xml",
+	"compositeContent.jar",
+	"compositeArtifacts.xml",
+	"compositeArtifacts.jar",
+	"content.xml",
+	"content.jar",
+	"artifacts.xml",
+	"artifacts.jar",
+	"p2.index",
+}
+
+var p2RemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "p2"), map[string]*schema.Schema{
+	"url": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.All(validation.IsURLWithHTTPorHTTPS, validateP2UpdateSiteUrl),
+		Description: "The URL of the update site, simple or composite, e.g. 'https://download.eclipse.org/releases/latest'. " +
+			"The children of a composite site are resolved relative to it.",
+	},
+	"list_remote_folder_items": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Lists the items of remote folders in simple and list browsing, so the children of a composite update site can be browsed. Default value is 'true'.",
+	},
+})
+
+// validateP2UpdateSiteUrl rejects the url of the metadata of an update site instead of the site itself
+func validateP2UpdateSiteUrl(value interface{}, key string) ([]string, []error) {
+	siteUrl, err := url.Parse(value.(string))
+	if err != nil {
+		return nil, nil
+	}
+	file := path.Base(siteUrl.Path)
+	for _, metadataFile := range p2MetadataFiles {
+		if file == metadataFile {
+			return nil, []error{fmt.Errorf("%s must be the update site holding %s, e.g. %s", key, file, siteUrl.ResolveReference(&url.URL{Path: "."}))}
+		}
+	}
+	return nil, nil
+}
+
+type P2RemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteP2Repository() *schema.Resource {
+	return mkResourceSchema(p2RemoteSchema, defaultPacker, unpackP2RemoteRepo, func() interface{} {
+		return &P2RemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "p2",
+				RepoLayoutRef: defaultRepoLayoutRefs["p2"],
+			},
+		}
+	})
+}
+
+func unpackP2RemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := P2RemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "p2"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,30 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pubRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "pub"))
+
+type PubRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemotePubRepository() *schema.Resource {
+	return mkResourceSchema(pubRemoteSchema, defaultPacker, unpackPubRemoteRepo, func() interface{} {
+		return &PubRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "pub",
+				RepoLayoutRef: defaultRepoLayoutRefs["pub"],
+			},
+		}
+	})
+}
+
+func unpackPubRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := PubRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "pub"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -17,8 +17,8 @@ var pypiRemoteSchema = mergeSchema(baseRemoteSchema, map[string]*schema.Schema{
 		Type:             schema.TypeString,
 		Optional:         true,
 		Default:          "simple",
-		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
-		Description:      `(Optional) Usually should be left as a default for 'simple', unless the remote is a PyPI server that has custom registry suffix, like +simple in DevPI. Default value is 'simple'.`,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringDoesNotContainAny("/")),
+		Description:      `(Optional) Usually should be left as a default for 'simple', unless the remote is a PyPI server that has custom registry suffix, like +simple in DevPI, or none at all, like some Nexus mirrors. Default value is 'simple'.`,
 	},
 })
 
@@ -1,15 +1,26 @@
 package artifactory
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccLocalAllowDotsUnderscorersAndDashesInKeyGH129(t *testing.T) {
@@ -84,6 +95,60 @@ func TestAccRemoteDockerRepository(t *testing.T) {
 	resource.Test(t, testCase)
 }
 
+func TestAccRemoteSmartRepositoryWithStatistics(t *testing.T) {
+	_, fqrn, name := mkNames("smart-remote", "artifactory_remote_npm_repository")
+	_, _, localName := mkNames("smart-local", "artifactory_local_npm_repository")
+	params := map[string]interface{}{
+		"name":      name,
+		"localName": localName,
+		"url":       strings.TrimSuffix(os.Getenv("ARTIFACTORY_URL"), "/"),
+	}
+	smartRemote := executeTemplate(name, `
+		resource "artifactory_local_npm_repository" "{{ .localName }}" {
+			key = "{{ .localName }}"
+		}
+
+		resource "artifactory_remote_npm_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+			url = "{{ .url }}/artifactory/api/npm/${artifactory_local_npm_repository.{{ .localName }}.key}"
+			content_synchronisation {
+				enabled                         = true
+				statistics_enabled              = true
+				properties_enabled              = true
+				source_origin_absence_detection = true
+			}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: smartRemote,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.statistics_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.properties_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.source_origin_absence_detection", "true"),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config:             smartRemote,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func TestAccRemoteCargoRepository(t *testing.T) {
 	_, testCase := mkNewRemoteTestCase("cargo", t, map[string]interface{}{
 		"git_registry_url":            "https://github.com/rust-lang/foo.index",
@@ -101,6 +166,66 @@ func TestAccRemoteCargoRepository(t *testing.T) {
 	resource.Test(t, testCase)
 }
 
+func TestAccRemoteCargoRepositorySparseIndex(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("cargo", t, map[string]interface{}{
+		"url":                 "https://index.crates.io/",
+		"enable_sparse_index": true,
+		"anonymous_access":    true,
+	}))
+}
+
+func TestAccRemoteCargoRepositoryDefaultGitIndex(t *testing.T) {
+	_, fqrn, name := mkNames("cargo-remote", "artifactory_remote_cargo_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_cargo_repository" "%[1]s" {
+			key = "%[1]s"
+			url = "https://github.com/rust-lang/crates.io-index"
+		}
+	`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enable_sparse_index", "false"),
+					resource.TestCheckResourceAttr(fqrn, "git_registry_url", cargoDefaultGitRegistryUrl),
+				),
+			},
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccRemoteCargoRepositorySparseIndexWithGitIndex(t *testing.T) {
+	_, _, name := mkNames("cargo-remote", "artifactory_remote_cargo_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_cargo_repository" "%[1]s" {
+			key                 = "%[1]s"
+			url                 = "https://index.crates.io/"
+			enable_sparse_index = true
+			git_registry_url    = "https://github.com/rust-lang/foo.index"
+		}
+	`, name)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("is not used with enable_sparse_index"),
+			},
+		},
+	})
+}
+
 func TestAccRemoteHelmRepository(t *testing.T) {
 	resource.Test(mkNewRemoteTestCase("helm", t, map[string]interface{}{
 		"helm_charts_base_url":           "https://github.com/rust-lang/foo.index",
@@ -118,6 +243,38 @@ func TestAccRemoteHelmRepository(t *testing.T) {
 	}))
 }
 
+func TestAccRemoteHelmOciRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("helm_oci", t, map[string]interface{}{
+		"url":                            "https://registry-1.docker.io/",
+		"repo_layout_ref":                "simple-default",
+		"external_dependencies_enabled":  true,
+		"external_dependencies_patterns": []interface{}{"**registry-1.docker.io**"},
+	}))
+}
+
+func TestAccRemoteHelmOciRepositoryWithChartsBaseUrl(t *testing.T) {
+	_, fqrn, name := mkNames("helm-oci-remote", "artifactory_remote_helm_oci_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_helm_oci_repository" "%s" {
+			key                  = "%s"
+			url                  = "https://registry-1.docker.io/"
+			helm_charts_base_url = "https://charts.example.com"
+		}
+	`, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*An argument named "helm_charts_base_url" is not expected here.*`),
+			},
+		},
+	})
+}
+
 func TestAccRemoteNpmRepository(t *testing.T) {
 	resource.Test(mkNewRemoteTestCase("npm", t, map[string]interface{}{
 		"list_remote_folder_items":             true,
@@ -133,9 +290,91 @@ func TestAccRemoteNpmRepository(t *testing.T) {
 	}))
 }
 
+func TestAccRemoteNpmRepositoryBypassHeadRequests(t *testing.T) {
+	_, fqrn, name := mkNames("npm-remote", "artifactory_remote_npm_repository")
+	const config = `
+		resource "artifactory_remote_npm_repository" "%s" {
+			key = "%s"
+			url = "https://registry.npmjs.org/"
+			%s
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, name, name, "bypass_head_requests = true"),
+				Check:  resource.TestCheckResourceAttr(fqrn, "bypass_head_requests", "true"),
+			},
+			{
+				Config: fmt.Sprintf(config, name, name, ""),
+				Check:  resource.TestCheckResourceAttr(fqrn, "bypass_head_requests", "false"),
+			},
+		},
+	})
+}
+
+func TestAccRemoteNpmRepositoryScopedPackage(t *testing.T) {
+	_, fqrn, name := mkNames("npm-remote", "artifactory_remote_npm_repository")
+	const config = `
+		resource "artifactory_remote_npm_repository" "%s" {
+			key                  = "%s"
+			url                  = "%s"
+			bypass_head_requests = true
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, name, name, "https://registry.npmjs.org/@types"),
+				ExpectError: regexp.MustCompile(".*must point to the root of the npm registry, not to the scope @types.*"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "artifactory_remote_npm_repository" "%s" {
+						key                                  = "%s"
+						url                                  = "https://registry.npmjs.org/"
+						block_mismatching_mime_types         = false
+						mismatching_mime_types_override_list = "application/json"
+					}
+				`, name, name),
+				ExpectError: regexp.MustCompile(".*mismatching_mime_types_override_list has no effect unless block_mismatching_mime_types is true.*"),
+			},
+			{
+				Config: fmt.Sprintf(config, name, name, "https://registry.npmjs.org/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "bypass_head_requests", "true"),
+					func(s *terraform.State) error {
+						// the npm client escapes the slash of scoped package names
+						metadata := struct {
+							Name string `json:"name"`
+						}{}
+						_, err := getTestResty(t).R().SetResult(&metadata).Get(fmt.Sprintf("artifactory/api/npm/%s/@types%%2fnode", name))
+						if err != nil {
+							return err
+						}
+						if metadata.Name != "@types/node" {
+							return fmt.Errorf("expected the metadata of @types/node, got %s", metadata.Name)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccRemotePypiRepository(t *testing.T) {
 	resource.Test(mkNewRemoteTestCase("pypi", t, map[string]interface{}{
 		"pypi_registry_url":           "https://pypi.org",
+		"pypi_repository_suffix":      "simple",
 		"priority_resolution":         true,
 		"missed_cache_period_seconds": 1800, // https://github.com/jfrog/terraform-provider-artifactory/issues/225
 		"list_remote_folder_items":    true,
@@ -150,8 +389,12 @@ func TestAccRemotePypiRepository(t *testing.T) {
 
 func TestAccRemoteMavenRepository(t *testing.T) {
 	resource.Test(mkNewRemoteTestCase("maven", t, map[string]interface{}{
-		"missed_cache_period_seconds": 1800, // https://github.com/jfrog/terraform-provider-artifactory/issues/225
-		"list_remote_folder_items":    true,
+		"missed_cache_period_seconds":     1800, // https://github.com/jfrog/terraform-provider-artifactory/issues/225
+		"list_remote_folder_items":        true,
+		"fetch_jars_eagerly":              true,
+		"fetch_sources_eagerly":           true,
+		"reject_invalid_jars":             true,
+		"suppress_pom_consistency_checks": true,
 		"content_synchronisation": map[string]interface{}{
 			"enabled":                         false, // even when set to true, it seems to come back as false on the wire
 			"statistics_enabled":              true,
@@ -163,8 +406,15 @@ func TestAccRemoteMavenRepository(t *testing.T) {
 
 func TestAccRemoteGradleRepository(t *testing.T) {
 	resource.Test(mkNewRemoteTestCase("gradle", t, map[string]interface{}{
-		"missed_cache_period_seconds": 1800, // https://github.com/jfrog/terraform-provider-artifactory/issues/225
-		"list_remote_folder_items":    true,
+		"missed_cache_period_seconds":      1800, // https://github.com/jfrog/terraform-provider-artifactory/issues/225
+		"list_remote_folder_items":         true,
+		"repo_layout_ref":                  "maven-2-default",
+		"fetch_jars_eagerly":               true,
+		"fetch_sources_eagerly":            true,
+		"handle_releases":                  true,
+		"handle_snapshots":                 false,
+		"suppress_pom_consistency_checks":  true,
+		"remote_repo_checksum_policy_type": "ignore-and-generate",
 		"content_synchronisation": map[string]interface{}{
 			"enabled":                         false, // even when set to true, it seems to come back as false on the wire
 			"statistics_enabled":              true,
@@ -174,6 +424,639 @@ func TestAccRemoteGradleRepository(t *testing.T) {
 	}))
 }
 
+func TestAccRemoteGradlePluginPortalRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("gradle", t, map[string]interface{}{
+		"url":              "https://plugins.gradle.org/m2/",
+		"repo_layout_ref":  "maven-2-default",
+		"handle_snapshots": false,
+	}))
+}
+
+func TestAccRemoteGradlePluginPortalSiteRepository(t *testing.T) {
+	_, _, name := mkNames("gradle-remote", "artifactory_remote_gradle_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_gradle_repository" "%[1]s" {
+			key = "%[1]s"
+			url = "https://plugins.gradle.org"
+		}
+	`, name)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("use https://plugins.gradle.org/m2/ instead"),
+			},
+		},
+	})
+}
+
+func TestAccRemoteSbtRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("sbt", t, map[string]interface{}{
+		"url":                              "https://repo1.maven.org/maven2/",
+		"repo_layout_ref":                  "sbt-default",
+		"fetch_jars_eagerly":               true,
+		"fetch_sources_eagerly":            true,
+		"handle_releases":                  true,
+		"handle_snapshots":                 false,
+		"suppress_pom_consistency_checks":  false,
+		"remote_repo_checksum_policy_type": "fail",
+	}))
+}
+
+func TestAccRemoteIvyRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("ivy", t, map[string]interface{}{
+		"url":                              "https://repo1.maven.org/maven2/",
+		"repo_layout_ref":                  "ivy-default",
+		"fetch_jars_eagerly":               true,
+		"fetch_sources_eagerly":            true,
+		"handle_releases":                  true,
+		"handle_snapshots":                 false,
+		"suppress_pom_consistency_checks":  false,
+		"remote_repo_checksum_policy_type": "fail",
+	}))
+}
+
+func TestAccRemoteBowerRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("bower", t, map[string]interface{}{
+		"url":                  "https://github.com/",
+		"repo_layout_ref":      "bower-default",
+		"bower_registry_url":   "https://registry.bower.io",
+		"vcs_git_provider":     "CUSTOM",
+		"vcs_git_download_url": "https://www.customrepo.com",
+	}))
+}
+
+func TestAccRemoteCocoapodsRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("cocoapods", t, map[string]interface{}{
+		"url":                 "https://github.com/",
+		"repo_layout_ref":     "simple-default",
+		"pods_specs_repo_url": "https://github.com/CocoaPods/Specs",
+		"vcs_git_provider":    "GITHUB",
+	}))
+}
+
+func TestAccRemoteSwiftRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("swift", t, map[string]interface{}{
+		"url":              "https://github.com/",
+		"repo_layout_ref":  "simple-default",
+		"vcs_git_provider": "GITHUB",
+	}))
+}
+
+func TestRemoteCocoapodsRepositoryWithPrivateSpecsRepo(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "pods-remote")
+
+	cocoapods := resourceArtifactoryRemoteCocoapodsRepository()
+	if diags := cocoapods.Schema["vcs_git_provider"].ValidateDiagFunc("SUBVERSION", cty.GetAttrPath("vcs_git_provider")); !diags.HasError() {
+		t.Error("expected an unsupported vcs_git_provider to be rejected")
+	}
+
+	d := schema.TestResourceDataRaw(t, cocoapods.Schema, map[string]interface{}{
+		"key":                 "pods-remote",
+		"url":                 "https://github.com/",
+		"username":            "octocat",
+		"password":            "ghp_token",
+		"pods_specs_repo_url": "https://github.com/acme/private-specs",
+		"vcs_git_provider":    "GITHUB",
+	})
+	if diags := cocoapods.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+
+	if repo.saved["podsSpecsRepoUrl"] != "https://github.com/acme/private-specs" {
+		t.Errorf("expected the private specs repo to be sent, got %v", repo.saved["podsSpecsRepoUrl"])
+	}
+	if repo.saved["username"] != "octocat" || repo.password != "ghp_token" {
+		t.Errorf("expected the credentials for the specs repo to be sent, got %v/%v", repo.saved["username"], repo.password)
+	}
+	if d.Get("pods_specs_repo_url") != "https://github.com/acme/private-specs" {
+		t.Errorf("expected the specs repo to be read back, got %v", d.Get("pods_specs_repo_url"))
+	}
+}
+
+func TestRemoteJavaRepositorySuppressPomConsistencyChecks(t *testing.T) {
+	for packageType, config := range map[string]struct {
+		res      *schema.Resource
+		expected bool
+	}{
+		"maven":  {resourceArtifactoryRemoteJavaRepository("maven", false), false},
+		"gradle": {resourceArtifactoryRemoteJavaRepository("gradle", true), false},
+	} {
+		client, repo := mkFakeRepositoryServer(t, packageType+"-remote")
+		for _, suppressPom := range []interface{}{nil, !config.expected} {
+			raw := map[string]interface{}{
+				"key": packageType + "-remote",
+				"url": "https://repo1.maven.org/maven2/",
+			}
+			expected := config.expected
+			if suppressPom != nil {
+				raw["suppress_pom_consistency_checks"] = suppressPom
+				expected = suppressPom.(bool)
+			}
+
+			d := schema.TestResourceDataRaw(t, config.res.Schema, raw)
+			if diags := config.res.CreateContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("failed to create the %s repository: %v", packageType, diags)
+			}
+			if repo.saved["suppressPomConsistencyChecks"] != expected {
+				t.Errorf("expected suppressPomConsistencyChecks %v to be sent for %s with %v configured, got %v",
+					expected, packageType, suppressPom, repo.saved["suppressPomConsistencyChecks"])
+			}
+		}
+	}
+}
+
+func TestRemoteRepositoryPropagateQueryParams(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "generic-remote")
+
+	generic := resourceArtifactoryRemoteGenericRepository()
+	d := schema.TestResourceDataRaw(t, generic.Schema, map[string]interface{}{
+		"key":                    "generic-remote",
+		"url":                    "https://github.com/",
+		"propagate_query_params": true,
+	})
+	if diags := generic.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+
+	if repo.saved["propagateQueryParams"] != true {
+		t.Errorf("expected propagateQueryParams to be sent, got %v", repo.saved["propagateQueryParams"])
+	}
+	if d.Get("propagate_query_params") != true {
+		t.Errorf("expected propagate_query_params to be read back, got %v", d.Get("propagate_query_params"))
+	}
+
+	gitlfs := resourceArtifactoryRemoteGitLfsRepository()
+	diags := gitlfs.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":                    "lfs-remote",
+		"url":                    "https://github.com/",
+		"propagate_query_params": true,
+	}))
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning about propagate_query_params on a remote repository that isn't generic, got %v", diags)
+	}
+}
+
+func TestRemoteNpmRepositoryWarmup(t *testing.T) {
+	var created map[string]interface{}
+	var warmedUp []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/artifactory/api/repositories/npm-remote" && r.Method == http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &created); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case r.URL.Path == "/artifactory/api/repositories/npm-remote":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(created)
+		case strings.HasPrefix(r.URL.EscapedPath(), "/artifactory/api/npm/npm-remote/"):
+			name := strings.TrimPrefix(r.URL.EscapedPath(), "/artifactory/api/npm/npm-remote/")
+			warmedUp = append(warmedUp, name)
+			if name == "missing" {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	npm := resourceArtifactoryRemoteNpmRepository()
+	d := schema.TestResourceDataRaw(t, npm.Schema, map[string]interface{}{
+		"key":             "npm-remote",
+		"url":             "https://registry.npmjs.org/",
+		"warmup_packages": []interface{}{"lodash", "@types/node", "missing"},
+	})
+	diags := npm.CreateContext(context.Background(), d, client.SetRetryCount(0))
+	if diags.HasError() {
+		t.Fatalf("a failed warmup must not fail the apply: %v", diags)
+	}
+
+	if expected := []string{"lodash", "@types%2Fnode", "missing"}; !reflect.DeepEqual(warmedUp, expected) {
+		t.Errorf("expected %v to be warmed up, got %v", expected, warmedUp)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "missing") {
+		t.Errorf("expected a single warning for the missing package, got %v", diags)
+	}
+	if _, ok := created["warmupPackages"]; ok {
+		t.Error("warmup_packages is not a repository setting and must not be sent")
+	}
+}
+
+func TestRemoteRepositoryContentSynchronisationWarning(t *testing.T) {
+	client, _ := mkFakeRepositoryServer(t, "generic-remote")
+
+	generic := resourceArtifactoryRemoteGenericRepository()
+	for enabled, warned := range map[bool]bool{false: true, true: false} {
+		d := schema.TestResourceDataRaw(t, generic.Schema, map[string]interface{}{
+			"key": "generic-remote",
+			"url": "https://github.com/",
+			"content_synchronisation": []interface{}{map[string]interface{}{
+				"enabled":                         enabled,
+				"source_origin_absence_detection": true,
+			}},
+		})
+		diags := generic.CreateContext(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatalf("failed to create the repository: %v", diags)
+		}
+		if warned && (len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "source_origin_absence_detection")) {
+			t.Errorf("expected a warning about source_origin_absence_detection without content synchronisation, got %v", diags)
+		}
+		if !warned && len(diags) > 0 {
+			t.Errorf("expected no warning with content synchronisation enabled, got %v", diags)
+		}
+	}
+}
+
+func TestRemoteRepositoryRemoteRepoLayoutRef(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "generic-remote")
+
+	generic := resourceArtifactoryRemoteGenericRepository()
+	d := schema.TestResourceDataRaw(t, generic.Schema, map[string]interface{}{
+		"key":                    "generic-remote",
+		"url":                    "https://example.com/downloads/",
+		"repo_layout_ref":        "simple-default",
+		"remote_repo_layout_ref": "custom-downloads",
+	})
+	if diags := generic.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+	if repo.saved["remoteRepoLayoutRef"] != "custom-downloads" {
+		t.Errorf("expected remoteRepoLayoutRef to be sent, got %v", repo.saved["remoteRepoLayoutRef"])
+	}
+
+	// an update which doesn't touch the remote layout must keep it
+	d = generic.Data(d.State())
+	if diags := generic.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to update the repository: %v", diags)
+	}
+	if repo.saved["remoteRepoLayoutRef"] != "custom-downloads" {
+		t.Errorf("expected remoteRepoLayoutRef to be kept, got %v", repo.saved["remoteRepoLayoutRef"])
+	}
+	if d.Get("remote_repo_layout_ref") != "custom-downloads" {
+		t.Errorf("expected remote_repo_layout_ref to be read back, got %v", d.Get("remote_repo_layout_ref"))
+	}
+}
+
+func TestAccRemoteConanRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("conan", t, map[string]interface{}{
+		"url":                        "https://center.conan.io",
+		"repo_layout_ref":            "conan-default",
+		"force_conan_authentication": true,
+	}))
+}
+
+func TestAccRemoteCondaRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("conda", t, map[string]interface{}{
+		"url":                      "https://conda.anaconda.org/conda-forge",
+		"list_remote_folder_items": true,
+		"repo_layout_ref":          "conda-default",
+	}))
+}
+
+func TestAccRemoteCranRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("cran", t, map[string]interface{}{
+		"url":             "https://cran.r-project.org/",
+		"repo_layout_ref": "simple-default",
+	}))
+}
+
+func TestAccRemoteHuggingFaceMlRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("huggingfaceml", t, map[string]interface{}{
+		"url":             "https://huggingface.co",
+		"repo_layout_ref": "simple-default",
+	}))
+}
+
+func TestRemoteHuggingFaceMlRepositorySendsToken(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "hf-gated-remote")
+
+	huggingface := resourceArtifactoryRemoteHuggingFaceMlRepository()
+	d := schema.TestResourceDataRaw(t, huggingface.Schema, map[string]interface{}{
+		"key":      "hf-gated-remote",
+		"url":      "https://huggingface.co",
+		"password": "hf_token",
+	})
+	if diags := huggingface.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+
+	if repo.password != "hf_token" {
+		t.Errorf("expected the access token to be sent, got %v", repo.password)
+	}
+	if _, ok := repo.saved["username"]; ok && repo.saved["username"] != "" {
+		t.Errorf("expected no username to be needed, got %v", repo.saved["username"])
+	}
+	if password := d.State().Attributes["password"]; password != getMD5Hash("hf_token") {
+		t.Errorf("expected only the hash of the token to be kept, got %v", password)
+	}
+
+	for hubUrl, valid := range map[string]bool{
+		"https://huggingface.co":                       true,
+		"https://huggingface.co/":                      true,
+		"https://huggingface.co/meta-llama/Llama-2-7b": false,
+		"https://huggingface.co/datasets/squad":        false,
+	} {
+		if _, errs := validateHuggingFaceUrl(hubUrl, "url"); (len(errs) == 0) != valid {
+			t.Errorf("expected %s to be valid: %t, got %v", hubUrl, valid, errs)
+		}
+	}
+}
+
+func TestAccRemotePubRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("pub", t, map[string]interface{}{
+		"url":             "https://pub.dev",
+		"repo_layout_ref": "simple-default",
+	}))
+}
+
+func TestAccRemoteGitLfsRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("gitlfs", t, map[string]interface{}{
+		"url":             "https://github.com/",
+		"repo_layout_ref": "simple-default",
+	}))
+}
+
+func TestAccRemoteRpmRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("rpm", t, map[string]interface{}{
+		"url":                      "https://mirrors.edge.kernel.org/centos/",
+		"list_remote_folder_items": true,
+		"repo_layout_ref":          "simple-default",
+	}))
+}
+
+func TestAccRemoteVagrantRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("vagrant", t, map[string]interface{}{
+		"url":             "https://vagrantcloud.com/",
+		"repo_layout_ref": "simple-default",
+	}))
+}
+
+func TestAccRemoteP2Repository(t *testing.T) {
+	// a composite update site
+	resource.Test(mkNewRemoteTestCase("p2", t, map[string]interface{}{
+		"url":                      "https://download.eclipse.org/releases/latest/",
+		"list_remote_folder_items": true,
+		"repo_layout_ref":          "simple-default",
+	}))
+}
+
+func TestValidateP2UpdateSiteUrl(t *testing.T) {
+	for siteUrl, valid := range map[string]bool{
+		"https://download.eclipse.org/releases/latest/":                     true,
+		"https://download.eclipse.org/releases/latest":                      true,
+		"https://download.eclipse.org/releases/latest/compositeContent.jar": false,
+		"https://download.eclipse.org/releases/latest/content.xml":          false,
+	} {
+		if _, errs := validateP2UpdateSiteUrl(siteUrl, "url"); (len(errs) == 0) != valid {
+			t.Errorf("expected %s to be valid: %t, got %v", siteUrl, valid, errs)
+		}
+	}
+}
+
+func TestAccRemoteOpkgRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("opkg", t, map[string]interface{}{
+		"url":                      "https://downloads.openwrt.org/releases/22.03.5/packages/x86_64/base/",
+		"list_remote_folder_items": true,
+		"repo_layout_ref":          "simple-default",
+	}))
+}
+
+func TestValidateOpkgFeedUrl(t *testing.T) {
+	for feedUrl, valid := range map[string]bool{
+		"https://downloads.openwrt.org/releases/22.03.5/packages/x86_64/base/":            true,
+		"https://downloads.openwrt.org/releases/22.03.5/packages/x86_64/base":             true,
+		"https://downloads.openwrt.org/releases/22.03.5/packages/x86_64/base/Packages.gz": false,
+		"https://downloads.openwrt.org/releases/22.03.5/packages/x86_64/base/Packages":    false,
+	} {
+		if _, errs := validateOpkgFeedUrl(feedUrl, "url"); (len(errs) == 0) != valid {
+			t.Errorf("expected %s to be valid: %t, got %v", feedUrl, valid, errs)
+		}
+	}
+}
+
+func TestAccRemoteDebianRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("debian", t, map[string]interface{}{
+		"url":                      "http://archive.ubuntu.com/ubuntu/",
+		"list_remote_folder_items": true,
+		"trivial_layout":           false,
+		"repo_layout_ref":          "simple-default",
+	}))
+}
+
+func TestValidateDebianArchiveUrl(t *testing.T) {
+	for archiveUrl, expected := range map[string]string{
+		"http://archive.ubuntu.com/ubuntu/":                      "",
+		"http://archive.ubuntu.com/ubuntu":                       "",
+		"http://archive.ubuntu.com/ubuntu/dists/jammy/":          "http://archive.ubuntu.com/ubuntu/, not its dists directory",
+		"http://archive.ubuntu.com/ubuntu/dists/jammy/InRelease": "http://archive.ubuntu.com/ubuntu/, not its dists directory",
+		"https://deb.debian.org/debian/pool/main/h/hello/":       "https://deb.debian.org/debian/, not its pool directory",
+	} {
+		_, errs := validateDebianArchiveUrl(archiveUrl, "url")
+		if expected == "" && len(errs) > 0 {
+			t.Errorf("expected %s to be valid, got %v", archiveUrl, errs)
+		}
+		if expected != "" && (len(errs) == 0 || !strings.Contains(errs[0].Error(), expected)) {
+			t.Errorf("expected %s to be rejected with %q, got %v", archiveUrl, expected, errs)
+		}
+	}
+}
+
+func TestAccRemoteGenericRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("generic", t, map[string]interface{}{
+		"url":                    "https://releases.hashicorp.com/",
+		"repo_layout_ref":        "simple-default",
+		"remote_repo_layout_ref": "simple-default",
+		"propagate_query_params": true,
+		"query_params":           "org=jfrog&product=artifactory",
+	}))
+}
+
+func TestAccRemoteGoRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("go", t, map[string]interface{}{
+		"url":              "https://github.com/",
+		"vcs_git_provider": "GITHUB",
+		"repo_layout_ref":  "go-default",
+	}))
+}
+
+func TestAccRemoteGoRepositoryWithArtifactoryProvider(t *testing.T) {
+	// GOPROXY resolution, e.g. from another Artifactory instance acting as a Go smart remote
+	resource.Test(mkNewRemoteTestCase("go", t, map[string]interface{}{
+		"url":              "https://proxy.golang.org/",
+		"vcs_git_provider": "ARTIFACTORY",
+		"repo_layout_ref":  "go-default",
+	}))
+}
+
+func TestAccRemoteNugetRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("nuget", t, map[string]interface{}{
+		"url":                        "https://www.nuget.org/",
+		"feed_context_path":          "api/v2",
+		"download_context_path":      "api/v2/package",
+		"force_nuget_authentication": true,
+		"repo_layout_ref":            "nuget-default",
+	}))
+}
+
+func TestAccRemoteNugetRepositoryV3FeedDetection(t *testing.T) {
+	_, fqrn, name := mkNames("nuget-remote", "artifactory_remote_nuget_repository")
+	const config = `
+		resource "artifactory_remote_nuget_repository" "%s" {
+			key = "%s"
+			url = "%s"
+		}
+	`
+	const v2ContextPathConfig = `
+		resource "artifactory_remote_nuget_repository" "%s" {
+			key               = "%s"
+			url               = "https://api.nuget.org/v3/index.json"
+			feed_context_path = "api/v2"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				// kept as set, with a warning on apply
+				Config: fmt.Sprintf(v2ContextPathConfig, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "v3_feed_url", "https://api.nuget.org/v3/index.json"),
+					resource.TestCheckResourceAttr(fqrn, "feed_context_path", "api/v2"),
+					resource.TestCheckResourceAttr(fqrn, "download_context_path", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, name, name, "https://www.nuget.org/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "feed_context_path", "api/v2"),
+					resource.TestCheckResourceAttr(fqrn, "download_context_path", "api/v2/package"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, name, name, "https://api.nuget.org/v3/index.json"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "v3_feed_url", "https://api.nuget.org/v3/index.json"),
+					resource.TestCheckResourceAttr(fqrn, "feed_context_path", ""),
+					resource.TestCheckResourceAttr(fqrn, "download_context_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestRemoteNugetRepositoryKeepsExplicitFeedSettings(t *testing.T) {
+	res := resourceArtifactoryRemoteNugetRepository()
+	const v3Url = "https://api.nuget.org/v3/index.json"
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"key": "nuget-remote", "url": "https://www.nuget.org/"})
+	d.SetId("nuget-remote")
+	state := d.State()
+	state.Attributes["feed_context_path"] = nugetV2FeedContextPath
+	state.Attributes["download_context_path"] = "custom/package"
+	state.Attributes["v3_feed_url"] = ""
+
+	// download_context_path is set explicitly and unchanged while the url changes to a v3 service index
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":                   "nuget-remote",
+		"url":                   v3Url,
+		"download_context_path": "custom/package",
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	if err != nil || diff == nil {
+		t.Fatalf("expected a diff for the url change, got %v", err)
+	}
+
+	if attr := diff.Attributes["download_context_path"]; attr != nil && attr.New != "custom/package" {
+		t.Errorf("expected download_context_path to be kept, got %v", attr)
+	}
+	if attr := diff.Attributes["feed_context_path"]; attr == nil || attr.New != "" {
+		t.Errorf("expected feed_context_path to be emptied, got %v", attr)
+	}
+	if attr := diff.Attributes["v3_feed_url"]; attr == nil || attr.New != v3Url {
+		t.Errorf("expected v3_feed_url to be set to %s, got %v", v3Url, attr)
+	}
+}
+
+func TestRemoteNugetRepositoryFeedContextPathWarning(t *testing.T) {
+	client, _ := mkFakeRepositoryServer(t, "nuget-remote")
+	res := resourceArtifactoryRemoteNugetRepository()
+	for _, config := range []struct {
+		url    string
+		warned bool
+	}{
+		{"https://api.nuget.org/v3/index.json", true},
+		{"https://www.nuget.org/", false},
+	} {
+		d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"key":               "nuget-remote",
+			"url":               config.url,
+			"feed_context_path": "api/v2",
+		})
+		diags := res.CreateContext(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatalf("failed to create the repository: %v", diags)
+		}
+		warned := len(diags) == 1 && diags[0].Severity == diag.Warning && strings.Contains(diags[0].Summary, "feed_context_path")
+		if warned != config.warned || (!config.warned && len(diags) > 0) {
+			t.Errorf("expected a feed_context_path warning to be %v for %s, got %v", config.warned, config.url, diags)
+		}
+	}
+}
+
+func TestAccRemoteVcsRepository(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("vcs", t, map[string]interface{}{
+		"url":                      "https://github.com/",
+		"vcs_git_provider":         "CUSTOM",
+		"vcs_git_download_url":     "https://www.customrepo.com",
+		"max_unique_snapshots":     5,
+		"list_remote_folder_items": true,
+	}))
+}
+
+func TestAccRemoteVcsRepositoryGitLabSelfManaged(t *testing.T) {
+	resource.Test(mkNewRemoteTestCase("vcs", t, map[string]interface{}{
+		// self-managed GitLab host, the download url is derived from it
+		"url":              "https://gitlab.example.com/",
+		"vcs_git_provider": "GITLAB",
+	}))
+}
+
+func TestAccRemoteVcsRepositoryDownloadUrlRequiresCustomProvider(t *testing.T) {
+	_, fqrn, name := mkNames("vcs-remote", "artifactory_remote_vcs_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_vcs_repository" "%s" {
+			key                  = "%s"
+			url                  = "https://github.com/"
+			vcs_git_provider     = "GITHUB"
+			vcs_git_download_url = "https://www.customrepo.com"
+		}
+	`, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*can only be set when vcs_git_provider is CUSTOM.*"),
+			},
+		},
+	})
+}
+
 func TestAccRemotePypiRepositoryWithCustomRegistryUrl(t *testing.T) {
 	extraFields := map[string]interface{}{
 		"pypi_registry_url": "https://custom.PYPI.registry.url",
@@ -181,6 +1064,108 @@ func TestAccRemotePypiRepositoryWithCustomRegistryUrl(t *testing.T) {
 	resource.Test(mkNewRemoteTestCase("pypi", t, extraFields))
 }
 
+func TestAccRemotePypiRepositoryWithEmptySuffix(t *testing.T) {
+	// mirrors serving the simple index at the root of the registry url, e.g. Nexus
+	extraFields := map[string]interface{}{
+		"pypi_registry_url":        "https://nexus.example.com/repository/pypi-proxy/simple",
+		"pypi_repository_suffix":   "",
+		"list_remote_folder_items": true,
+	}
+	resource.Test(mkNewRemoteTestCase("pypi", t, extraFields))
+}
+
+func TestRemoteDockerRepositorySendsUpstreamCredentials(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "ghcr-remote")
+
+	docker := resourceArtifactoryRemoteDockerRepository()
+	d := schema.TestResourceDataRaw(t, docker.Schema, map[string]interface{}{
+		"key":                         "ghcr-remote",
+		"url":                         "https://ghcr.io",
+		"username":                    "octocat",
+		"password":                    "ghp_token",
+		"enable_token_authentication": true,
+	})
+	if diags := docker.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+
+	if repo.saved["username"] != "octocat" {
+		t.Errorf("expected the upstream username to be sent, got %v", repo.saved["username"])
+	}
+	if repo.password != "ghp_token" {
+		t.Errorf("expected the upstream password to be sent, got %v", repo.password)
+	}
+	if repo.saved["enableTokenAuthentication"] != true {
+		t.Errorf("expected enableTokenAuthentication to be sent, got %v", repo.saved["enableTokenAuthentication"])
+	}
+}
+
+func TestRemoteDockerRepositoryCredentialsDiff(t *testing.T) {
+	docker := resourceArtifactoryRemoteDockerRepository()
+	state := &terraform.InstanceState{}
+
+	_, err := docker.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":      "ghcr-remote",
+		"url":      "https://ghcr.io",
+		"password": "ghp_token",
+	}), nil)
+	if err == nil || !strings.Contains(err.Error(), "username must be set") {
+		t.Errorf("expected a password without username to be rejected, got %v", err)
+	}
+
+	// e.g. a username taken from a resource which is yet to be created, the SDK marks unknown values with this uuid
+	_, err = docker.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":      "ghcr-remote",
+		"url":      "https://ghcr.io",
+		"username": "74D93920-ED26-11E3-AC10-0800200C9A66",
+		"password": "ghp_token",
+	}), nil)
+	if err != nil {
+		t.Errorf("expected a username unknown at plan time to be accepted, got %v", err)
+	}
+}
+
+func TestRemoteDockerRepositoryXrayIndexAndProject(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "myproj-docker-remote")
+
+	docker := resourceArtifactoryRemoteDockerRepository()
+	d := schema.TestResourceDataRaw(t, docker.Schema, map[string]interface{}{
+		"key":         "myproj-docker-remote",
+		"project_key": "myproj",
+		"url":         "https://registry-1.docker.io/",
+		"xray_index":  true,
+	})
+	if diags := docker.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to create the repository: %v", diags)
+	}
+	if repo.saved["xrayIndex"] != true {
+		t.Errorf("expected the repository to be submitted for indexing, got xrayIndex %v", repo.saved["xrayIndex"])
+	}
+	if repo.saved["projectKey"] != "myproj" {
+		t.Errorf("expected projectKey to be sent, got %v", repo.saved["projectKey"])
+	}
+	if d.Get("xray_index") != true || d.Get("project_key") != "myproj" {
+		t.Errorf("expected xray_index and project_key to be read back, got %v and %v", d.Get("xray_index"), d.Get("project_key"))
+	}
+
+	// an update which doesn't touch xray_index must keep the repository indexed
+	d = docker.Data(d.State())
+	if diags := docker.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to update the repository: %v", diags)
+	}
+	if repo.saved["xrayIndex"] != true {
+		t.Errorf("expected the repository to stay indexed after an update, got xrayIndex %v", repo.saved["xrayIndex"])
+	}
+}
+
+func TestAccRemoteDockerRepositoryWithXrayIndex(t *testing.T) {
+	_, testCase := mkNewRemoteTestCase("docker", t, map[string]interface{}{
+		"url":        "https://registry-1.docker.io/",
+		"xray_index": true,
+	})
+	resource.Test(t, testCase)
+}
+
 func TestAccRemoteDockerRepositoryWithListRemoteFolderItems(t *testing.T) {
 	extraFields := map[string]interface{}{
 		"list_remote_folder_items": true,
@@ -297,6 +1282,62 @@ func TestAccRemoteRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccRemoteRepository_contentSynchronisation(t *testing.T) {
+	_, fqrn, name := mkNames("terraform-remote-test-repo-content-sync", "artifactory_remote_npm_repository")
+	const remoteRepoContentSync = `
+		resource "artifactory_remote_npm_repository" "%s" {
+			key = "%s"
+			url = "https://registry.npmjs.org/"
+			content_synchronisation {
+				statistics_enabled              = true
+				properties_enabled              = true
+				source_origin_absence_detection = %t
+			}
+		}
+	`
+	const remoteRepoContentSyncDefaults = `
+		resource "artifactory_remote_npm_repository" "%s" {
+			key = "%s"
+			url = "https://registry.npmjs.org/"
+			content_synchronisation {
+				statistics_enabled = true
+			}
+		}
+	`
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(remoteRepoContentSync, name, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.enabled", "false"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.statistics_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.properties_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.source_origin_absence_detection", "true"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// password is never returned via the API, so it cannot be "imported"
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: fmt.Sprintf(remoteRepoContentSyncDefaults, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.statistics_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.properties_enabled", "false"),
+					resource.TestCheckResourceAttr(fqrn, "content_synchronisation.0.source_origin_absence_detection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRemoteRepository_nugetNew(t *testing.T) {
 	const remoteRepoNuget = `
 		resource "artifactory_remote_repository" "%s" {
@@ -384,6 +1425,10 @@ func mkNewRemoteTestCase(repoType string, t *testing.T, extraFields map[string]i
 		"content_synchronisation": map[string]interface{}{
 			"enabled": false, // even when set to true, it seems to come back as false on the wire
 		},
+		// setting this to true requires the Curation add-on
+		"curated": false,
+		// redirecting downloads requires a cloud filestore
+		"download_redirect": false,
 	}
 	allFields := mergeMaps(defaultFields, extraFields)
 	allFieldsHcl := fmtMapToHcl(allFields)
@@ -0,0 +1,30 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var rpmRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "rpm"))
+
+type RpmRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteRpmRepository() *schema.Resource {
+	return mkResourceSchema(rpmRemoteSchema, defaultPacker, unpackRpmRemoteRepo, func() interface{} {
+		return &RpmRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "rpm",
+				RepoLayoutRef: defaultRepoLayoutRefs["rpm"],
+			},
+		}
+	})
+}
+
+func unpackRpmRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := RpmRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "rpm"),
+	}
+	return repo, repo.Id(), nil
+}
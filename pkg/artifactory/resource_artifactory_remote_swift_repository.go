@@ -0,0 +1,39 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var swiftRemoteSchema = mergeSchema(baseRemoteSchema, vcsGitSchema, repoLayoutRefSchema("remote", "swift"))
+
+type SwiftRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	VcsGitProvider    string `hcl:"vcs_git_provider" json:"vcsGitProvider"`
+	VcsGitDownloadUrl string `hcl:"vcs_git_download_url" json:"vcsGitDownloadUrl"`
+}
+
+func resourceArtifactoryRemoteSwiftRepository() *schema.Resource {
+	resource := mkResourceSchema(swiftRemoteSchema, defaultPacker, unpackSwiftRemoteRepo, func() interface{} {
+		return &SwiftRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "swift",
+				RepoLayoutRef: defaultRepoLayoutRefs["swift"],
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, vcsGitDownloadUrlDiff)
+
+	return resource
+}
+
+func unpackSwiftRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := SwiftRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "swift"),
+		VcsGitProvider:             d.getString("vcs_git_provider", false),
+		VcsGitDownloadUrl:          d.getString("vcs_git_download_url", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,30 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var vagrantRemoteSchema = mergeSchema(baseRemoteSchema, repoLayoutRefSchema("remote", "vagrant"))
+
+type VagrantRemoteRepo struct {
+	RemoteRepositoryBaseParams
+}
+
+func resourceArtifactoryRemoteVagrantRepository() *schema.Resource {
+	return mkResourceSchema(vagrantRemoteSchema, defaultPacker, unpackVagrantRemoteRepo, func() interface{} {
+		return &VagrantRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:        "remote",
+				PackageType:   "vagrant",
+				RepoLayoutRef: defaultRepoLayoutRefs["vagrant"],
+			},
+		}
+	})
+}
+
+func unpackVagrantRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	repo := VagrantRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "vagrant"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,82 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var vcsGitProvidersSupported = []string{"GITHUB", "BITBUCKET", "OLDSTASH", "STASH", "ARTIFACTORY", "GITLAB", "CUSTOM"}
+
+// vcsGitSchema is shared by the remote package types that fetch their packages from a Git provider
+var vcsGitSchema = map[string]*schema.Schema{
+	"vcs_git_provider": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "GITHUB",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(vcsGitProvidersSupported, false)),
+		Description:      `(Optional) Artifactory supports proxying the following Git providers out-of-the-box: GitHub, GitLab, Bitbucket or a remote Artifactory instance. With "GITLAB", the url can be any GitLab host, self-managed ones included, and the download URLs are derived from it. Default value is "GITHUB".`,
+	},
+	"vcs_git_download_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		Description:      `(Optional) This attribute is used when vcs_git_provider is set to 'CUSTOM'. Provided URL will be used as proxy.`,
+	},
+}
+
+var vcsRemoteSchema = mergeSchema(baseRemoteSchema, vcsGitSchema, map[string]*schema.Schema{
+	"vcs_type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "GIT",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"GIT"}, false)),
+		Description:      `(Optional) VCS type. Default value is "GIT".`,
+	},
+}, maxUniqueSnapshotsSchema)
+
+type VcsRemoteRepo struct {
+	RemoteRepositoryBaseParams
+	VcsGitProvider     string `hcl:"vcs_git_provider" json:"vcsGitProvider"`
+	VcsType            string `hcl:"vcs_type" json:"vcsType"`
+	MaxUniqueSnapshots int    `hcl:"max_unique_snapshots" json:"maxUniqueSnapshots"`
+	VcsGitDownloadUrl  string `hcl:"vcs_git_download_url" json:"vcsGitDownloadUrl"`
+}
+
+func resourceArtifactoryRemoteVcsRepository() *schema.Resource {
+	resource := mkResourceSchema(vcsRemoteSchema, defaultPacker, unpackVcsRemoteRepo, func() interface{} {
+		return &VcsRemoteRepo{
+			RemoteRepositoryBaseParams: RemoteRepositoryBaseParams{
+				Rclass:      "remote",
+				PackageType: "vcs",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, vcsGitDownloadUrlDiff)
+
+	return resource
+}
+
+func vcsGitDownloadUrlDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	provider := diff.Get("vcs_git_provider").(string)
+	if downloadUrl, ok := diff.GetOk("vcs_git_download_url"); ok && provider != "CUSTOM" {
+		return fmt.Errorf("vcs_git_download_url %s can only be set when vcs_git_provider is CUSTOM, not %s", downloadUrl, provider)
+	}
+
+	return nil
+}
+
+func unpackVcsRemoteRepo(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := VcsRemoteRepo{
+		RemoteRepositoryBaseParams: unpackBaseRemoteRepo(s, "vcs"),
+		VcsGitProvider:             d.getString("vcs_git_provider", false),
+		VcsType:                    d.getString("vcs_type", false),
+		MaxUniqueSnapshots:         d.getInt("max_unique_snapshots", false),
+		VcsGitDownloadUrl:          d.getString("vcs_git_download_url", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -48,10 +48,26 @@ var repMultipleSchema = map[string]*schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
 		Elem: &schema.Resource{
-			Schema: replicationSchema,
+			Schema: mergeSchema(replicationSchema, replicationScheduleSchema),
 		},
 	},
 }
+
+// replicationScheduleSchema lets a single replication of a multi-replication config override the top level schedule
+var replicationScheduleSchema = map[string]*schema.Schema{
+	"cron_exp": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateCron,
+		Description:  "Cron expression for this replication. Defaults to the top level cron_exp.",
+	},
+	"enable_event_replication": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Enable event replication for this replication even when the top level enable_event_replication is off.",
+	},
+}
+
 var replicationSchema = map[string]*schema.Schema{
 	"url": {
 		Type:         schema.TypeString,
@@ -70,16 +86,20 @@ var replicationSchema = map[string]*schema.Schema{
 		Optional: true,
 	},
 	"password": {
-		Type:      schema.TypeString,
-		Computed:  true,
-		Sensitive: true,
-		Description: "If a password is used to create the resource, it will be returned as encrypted and this will become the new state." +
-			"Practically speaking, what this means is that, the password can only be set, not gotten. ",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		StateFunc:        getMD5Hash,
+		DiffSuppressFunc: keepReplicationPasswordDiff,
+		Description: "Only a hash of the password is kept in the state. The password is only sent when it changes in the " +
+			"configuration, leaving it unchanged keeps the password already set on the target. The password can't be " +
+			"cleared: removing it from the configuration or setting it empty keeps the one already set as well.",
 	},
 	"enabled": {
-		Type:     schema.TypeBool,
-		Optional: true,
-		Computed: true,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "When set, this replication will be enabled when saved. Set it to false to pause the replication. Default value is 'true'.",
 	},
 	"sync_deletes": {
 		Type:     schema.TypeBool,
@@ -107,6 +127,30 @@ var replicationSchema = map[string]*schema.Schema{
 	},
 }
 
+// keepReplicationPasswordDiff hides the removal of the password from the configuration, since an empty password is not
+// sent and the one already set on the target is kept
+func keepReplicationPasswordDiff(_, _, new string, _ *schema.ResourceData) bool {
+	return new == ""
+}
+
+// replicationPassword is the password to send for the replication at key. Only a password changed in the configuration
+// is sent: an unchanged one is only known as the hash in the state, and leaving it out keeps the one set on the target
+func replicationPassword(d *schema.ResourceData, key string) string {
+	if !d.HasChange(key) {
+		return ""
+	}
+	return d.Get(key).(string)
+}
+
+// replicationPasswordState is the hash kept in the state for the password at key. The password read back from
+// Artifactory is ignored, it's scrambled when password encryption is turned on
+func replicationPasswordState(d *schema.ResourceData, key string) string {
+	if d.HasChange(key) {
+		return getMD5Hash(d.Get(key).(string))
+	}
+	return d.Get(key).(string)
+}
+
 func resourceArtifactoryReplicationConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceReplicationConfigCreate,
@@ -149,6 +193,16 @@ func unpackReplicationConfig(s *schema.ResourceData) UpdateReplicationConfig {
 
 			replication.RepoKey = repo
 
+			replication.CronExp = d.getString("cron_exp", false)
+			if v, ok = m["cron_exp"]; ok && v.(string) != "" {
+				replication.CronExp = v.(string)
+			}
+
+			replication.EnableEventReplication = d.getBool("enable_event_replication", false)
+			if v, ok = m["enable_event_replication"]; ok && v.(bool) {
+				replication.EnableEventReplication = true
+			}
+
 			if v, ok = m["url"]; ok {
 				replication.URL = v.(string)
 			}
@@ -185,9 +239,7 @@ func unpackReplicationConfig(s *schema.ResourceData) UpdateReplicationConfig {
 				replication.Proxy = handleResetWithNonExistantValue(d, fmt.Sprintf("replications.%d.proxy", i))
 			}
 
-			if pass, ok := m["password"]; ok {
-				replication.Password = pass.(string)
-			}
+			replication.Password = replicationPassword(s, fmt.Sprintf("replications.%d.password", i))
 
 			replicationConfig.Replications = append(replicationConfig.Replications, replication)
 		}
@@ -206,13 +258,22 @@ func packReplicationConfig(replicationConfig *GetReplicationConfig, d *schema.Re
 
 	if replicationConfig.Replications != nil {
 		var replications []map[string]interface{}
-		for _, repo := range replicationConfig.Replications {
+		for i, repo := range replicationConfig.Replications {
 			replication := make(map[string]interface{})
 
+			// a replication following the top level schedule keeps what it's configured with, so it keeps following it
+			replication["cron_exp"] = repo.CronExp
+			if repo.CronExp == replicationConfig.CronExp {
+				replication["cron_exp"] = d.Get(fmt.Sprintf("replications.%d.cron_exp", i))
+			}
+			replication["enable_event_replication"] = repo.EnableEventReplication
+			if repo.EnableEventReplication == replicationConfig.EnableEventReplication {
+				replication["enable_event_replication"] = d.Get(fmt.Sprintf("replications.%d.enable_event_replication", i))
+			}
 			replication["url"] = repo.URL
 			replication["socket_timeout_millis"] = repo.SocketTimeoutMillis
 			replication["username"] = repo.Username
-			replication["password"] = repo.Password
+			replication["password"] = replicationPasswordState(d, fmt.Sprintf("replications.%d.password", i))
 			replication["enabled"] = repo.Enabled
 			replication["sync_deletes"] = repo.SyncDeletes
 			replication["sync_properties"] = repo.SyncProperties
@@ -233,6 +294,9 @@ func packReplicationConfig(replicationConfig *GetReplicationConfig, d *schema.Re
 
 func resourceReplicationConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	replicationConfig := unpackReplicationConfig(d)
+	if diags := checkReplicationRepoExists(m.(*resty.Client), d.Get("repo_key").(string)); diags != nil {
+		return diags
+	}
 
 	_, err := m.(*resty.Client).R().SetBody(replicationConfig).Put("artifactory/api/replications/multiple/" + replicationConfig.RepoKey)
 	if err != nil {
@@ -257,8 +321,21 @@ func resourceReplicationConfigRead(_ context.Context, d *schema.ResourceData, m
 		Replications: replications,
 	}
 	if len(replications) > 0 {
-		repConfig.EnableEventReplication = replications[0].EnableEventReplication
+		// the top level schedule isn't returned on its own, it's the one of the first replication not overriding it
 		repConfig.CronExp = replications[0].CronExp
+		for i, replication := range replications {
+			if d.Get(fmt.Sprintf("replications.%d.cron_exp", i)).(string) == "" {
+				repConfig.CronExp = replication.CronExp
+				break
+			}
+		}
+		repConfig.EnableEventReplication = replications[0].EnableEventReplication
+		for i, replication := range replications {
+			if !d.Get(fmt.Sprintf("replications.%d.enable_event_replication", i)).(bool) {
+				repConfig.EnableEventReplication = replication.EnableEventReplication
+				break
+			}
+		}
 	}
 	return packReplicationConfig(&repConfig, d)
 }
@@ -1,12 +1,17 @@
 package artifactory
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -120,6 +125,117 @@ func TestAccReplication_full(t *testing.T) {
 	})
 }
 
+func TestAccReplication_perReplicationSchedule(t *testing.T) {
+	const replicationConfigTemplate = `
+		resource "artifactory_local_repository" "lib-local" {
+			key = "lib-local"
+			package_type = "maven"
+		}
+
+		resource "artifactory_replication_config" "lib-local" {
+			repo_key = "${artifactory_local_repository.lib-local.key}"
+			cron_exp = "%s"
+			enable_event_replication = false
+
+			replications {
+				url = "%s"
+				username = "%s"
+				enable_event_replication = true
+			}
+
+			replications {
+				url = "%s"
+				username = "%s"
+				cron_exp = "0 0 2 * * ?"
+			}
+		}
+	`
+	const fqrn = "artifactory_replication_config.lib-local"
+	config := func(cronExp string) string {
+		return fmt.Sprintf(
+			replicationConfigTemplate,
+			cronExp,
+			os.Getenv("ARTIFACTORY_URL"),
+			os.Getenv("ARTIFACTORY_USERNAME"),
+			os.Getenv("ARTIFACTORY_URL")+"/second",
+			os.Getenv("ARTIFACTORY_USERNAME"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckReplicationDestroy(fqrn),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: config("0 0 * * * ?"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "cron_exp", "0 0 * * * ?"),
+					resource.TestCheckResourceAttr(fqrn, "enable_event_replication", "false"),
+					resource.TestCheckResourceAttr(fqrn, "replications.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "replications.0.cron_exp", ""),
+					resource.TestCheckResourceAttr(fqrn, "replications.0.enable_event_replication", "true"),
+					resource.TestCheckResourceAttr(fqrn, "replications.1.cron_exp", "0 0 2 * * ?"),
+					resource.TestCheckResourceAttr(fqrn, "replications.1.enable_event_replication", "false"),
+				),
+			},
+			{
+				// the top level cron_exp is read back from the first replication, so it only matches once it reached it
+				Config: config("0 0 12 * * ?"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "cron_exp", "0 0 12 * * ?"),
+					resource.TestCheckResourceAttr(fqrn, "replications.0.cron_exp", ""),
+					resource.TestCheckResourceAttr(fqrn, "replications.1.cron_exp", "0 0 2 * * ?"),
+				),
+			},
+		},
+	})
+}
+
+func TestReplicationConfigReadsScheduleFromReplications(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]getReplicationBody{
+			{ReplicationBody: ReplicationBody{URL: "http://localhost:8080/first", CronExp: "0 0 2 * * ?", EnableEventReplication: true}},
+			{ReplicationBody: ReplicationBody{URL: "http://localhost:8080/second", CronExp: "0 0 12 * * ?"}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := resourceArtifactoryReplicationConfig()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"repo_key": "lib-local",
+		"cron_exp": "0 0 * * * ?",
+		"replications": []interface{}{
+			map[string]interface{}{"url": "http://localhost:8080/first", "cron_exp": "0 0 2 * * ?", "enable_event_replication": true},
+			map[string]interface{}{"url": "http://localhost:8080/second"},
+		},
+	})
+	d.SetId("lib-local")
+	if diags := res.ReadContext(context.Background(), d, client.SetRetryCount(0)); diags.HasError() {
+		t.Fatalf("failed to read the replication config: %v", diags)
+	}
+
+	if cronExp := d.Get("cron_exp"); cronExp != "0 0 12 * * ?" {
+		t.Errorf("expected the top level cron_exp of the replication following it, got %v", cronExp)
+	}
+	if d.Get("enable_event_replication").(bool) {
+		t.Error("expected the top level enable_event_replication of the replication following it")
+	}
+	if cronExp := d.Get("replications.0.cron_exp"); cronExp != "0 0 2 * * ?" {
+		t.Errorf("expected the overridden cron_exp to be kept, got %v", cronExp)
+	}
+	if cronExp := d.Get("replications.1.cron_exp"); cronExp != "" {
+		t.Errorf("expected the replication to keep following the top level cron_exp, got %v", cronExp)
+	}
+}
+
 func testAccCheckReplicationDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -0,0 +1,259 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const retentionPoliciesEndpoint = "artifactory/api/cleanup/policies/"
+
+const retentionPolicyRunEndpoint = "artifactory/api/cleanup/run/"
+
+// RetentionPolicy is the payload of the cleanup policies API, available from Artifactory 7.90
+// https://jfrog.com/help/r/jfrog-rest-apis/cleanup-policies-apis
+type RetentionPolicy struct {
+	Key               string                        `json:"key"`
+	Description       string                        `json:"description,omitempty"`
+	CronExp           string                        `json:"cronExp"`
+	DurationInMinutes int                           `json:"durationInMinutes"`
+	Enabled           bool                          `json:"enabled"`
+	SkipTrashcan      bool                          `json:"skipTrashcan"`
+	SearchCriteria    RetentionPolicySearchCriteria `json:"searchCriteria"`
+}
+
+type RetentionPolicySearchCriteria struct {
+	PackageTypes          []string `json:"packageTypes"`
+	Repos                 []string `json:"repos"`
+	ExcludedRepos         []string `json:"excludedRepos"`
+	IncludedPackages      []string `json:"includedPackages"`
+	ExcludedPackages      []string `json:"excludedPackages"`
+	CreatedBeforeInMonths int      `json:"createdBeforeInMonths"`
+	KeepLastNVersions     int      `json:"keepLastNVersions"`
+}
+
+func resourceArtifactoryRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createRetentionPolicy,
+		ReadContext:   readRetentionPolicy,
+		UpdateContext: updateRetentionPolicy,
+		DeleteContext: deleteRetentionPolicy,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manage a cleanup policy, which deletes the packages matching its criteria on a schedule.\n" +
+			"https://jfrog.com/help/r/jfrog-rest-apis/cleanup-policies-apis",
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The identifier of the policy.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cron_exp": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCron,
+				Description:  "Cron expression that controls when the policy runs.",
+			},
+			"duration_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum time a run of the policy is allowed to take. Default value is 60.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the policy runs on its schedule. Ignored while dry_run is set. Default value is 'true'.",
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Keep the policy disabled and only preview what it would delete: a dry run is started after every apply " +
+					"and its report is available in Artifactory. Default value is 'false'.",
+			},
+			"skip_trashcan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the packages permanently instead of moving them to the trash can. Default value is 'false'.",
+			},
+			"package_types": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: repoTypeValidator},
+				Description: "The package types the policy applies to.",
+			},
+			"repos": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The repositories the policy applies to. Use '**' for all of them.",
+			},
+			"exclude_repos": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The repositories left out of the policy.",
+			},
+			"include_patterns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Patterns of the package names the policy applies to. All packages are included when empty.",
+			},
+			"exclude_patterns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Patterns of the package names left out of the policy.",
+			},
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of latest versions of each package to keep, the older ones are deleted. A value of 0 (default) keeps no particular number.",
+			},
+			"max_age_months": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Delete the packages created more than this number of months ago. A value of 0 (default) disables the age criteria.",
+			},
+		},
+	}
+}
+
+func unpackRetentionPolicy(s *schema.ResourceData) RetentionPolicy {
+	d := &ResourceData{s}
+
+	return RetentionPolicy{
+		Key:               d.getString("key", false),
+		Description:       d.getString("description", false),
+		CronExp:           d.getString("cron_exp", false),
+		DurationInMinutes: d.getInt("duration_in_minutes", false),
+		// a dry run is only allowed on a disabled policy
+		Enabled:      d.getBool("enabled", false) && !d.getBool("dry_run", false),
+		SkipTrashcan: d.getBool("skip_trashcan", false),
+		SearchCriteria: RetentionPolicySearchCriteria{
+			PackageTypes:          d.getSet("package_types"),
+			Repos:                 d.getSet("repos"),
+			ExcludedRepos:         d.getSet("exclude_repos"),
+			IncludedPackages:      d.getSet("include_patterns"),
+			ExcludedPackages:      d.getSet("exclude_patterns"),
+			CreatedBeforeInMonths: d.getInt("max_age_months", false),
+			KeepLastNVersions:     d.getInt("max_count", false),
+		},
+	}
+}
+
+func packRetentionPolicy(policy *RetentionPolicy, d *schema.ResourceData) diag.Diagnostics {
+	setValue := mkLens(d)
+	setValue("key", policy.Key)
+	setValue("description", policy.Description)
+	setValue("cron_exp", policy.CronExp)
+	setValue("duration_in_minutes", policy.DurationInMinutes)
+	// the policy is disabled on purpose during a dry run, so enabled keeps the configured value
+	if !d.Get("dry_run").(bool) {
+		setValue("enabled", policy.Enabled)
+	}
+	setValue("skip_trashcan", policy.SkipTrashcan)
+	setValue("package_types", schema.NewSet(schema.HashString, castToInterfaceArr(policy.SearchCriteria.PackageTypes)))
+	setValue("repos", schema.NewSet(schema.HashString, castToInterfaceArr(policy.SearchCriteria.Repos)))
+	setValue("exclude_repos", schema.NewSet(schema.HashString, castToInterfaceArr(policy.SearchCriteria.ExcludedRepos)))
+	setValue("include_patterns", schema.NewSet(schema.HashString, castToInterfaceArr(policy.SearchCriteria.IncludedPackages)))
+	setValue("exclude_patterns", schema.NewSet(schema.HashString, castToInterfaceArr(policy.SearchCriteria.ExcludedPackages)))
+	setValue("max_age_months", policy.SearchCriteria.CreatedBeforeInMonths)
+	errors := setValue("max_count", policy.SearchCriteria.KeepLastNVersions)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack retention policy %q", errors)
+	}
+
+	return nil
+}
+
+// startRetentionPolicyDryRun asks Artifactory for a report of what the policy would delete, without deleting anything
+func startRetentionPolicyDryRun(client *resty.Client, key string) error {
+	_, err := client.R().SetQueryParam("dryRun", "true").Post(retentionPolicyRunEndpoint + key)
+	if err != nil {
+		return fmt.Errorf("failed to start a dry run of retention policy %s: %s", key, err)
+	}
+	return nil
+}
+
+func createRetentionPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*resty.Client)
+	policy := unpackRetentionPolicy(d)
+
+	if _, err := client.R().SetBody(policy).Post(retentionPoliciesEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(policy.Key)
+
+	if d.Get("dry_run").(bool) {
+		if err := startRetentionPolicyDryRun(client, policy.Key); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readRetentionPolicy(ctx, d, m)
+}
+
+func readRetentionPolicy(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy := RetentionPolicy{}
+	resp, err := m.(*resty.Client).R().SetResult(&policy).AddRetryCondition(neverRetry).Get(retentionPoliciesEndpoint + d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return packRetentionPolicy(&policy, d)
+}
+
+func updateRetentionPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*resty.Client)
+	policy := unpackRetentionPolicy(d)
+
+	if _, err := client.R().SetBody(policy).Put(retentionPoliciesEndpoint + d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("dry_run").(bool) {
+		if err := startRetentionPolicyDryRun(client, policy.Key); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readRetentionPolicy(ctx, d, m)
+}
+
+func deleteRetentionPolicy(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := m.(*resty.Client).R().AddRetryCondition(neverRetry).Delete(retentionPoliciesEndpoint + d.Id())
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
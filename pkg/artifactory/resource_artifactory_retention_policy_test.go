@@ -0,0 +1,81 @@
+package artifactory
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRetentionPolicy(t *testing.T) {
+	_, fqrn, name := mkNames("retention-policy", "artifactory_retention_policy")
+	_, _, repoName := mkNames("retention-local", "artifactory_local_generic_repository")
+	params := map[string]interface{}{
+		"name":     name,
+		"repoName": repoName,
+		"enabled":  false,
+		"dryRun":   true,
+		"maxCount": 5,
+	}
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .repoName }}" {
+			key = "{{ .repoName }}"
+		}
+
+		resource "artifactory_retention_policy" "{{ .name }}" {
+			key              = "{{ .name }}"
+			description      = "test retention policy"
+			cron_exp         = "0 0 2 ? * SAT"
+			enabled          = {{ .enabled }}
+			dry_run          = {{ .dryRun }}
+			package_types    = ["generic"]
+			repos            = [artifactory_local_generic_repository.{{ .repoName }}.key]
+			include_patterns = ["**"]
+			exclude_patterns = ["keep-*"]
+			max_count        = {{ .maxCount }}
+			max_age_months   = 6
+		}
+	`
+	config := executeTemplate(name, template, params)
+
+	params["enabled"] = true
+	params["dryRun"] = false
+	params["maxCount"] = 10
+	updatedConfig := executeTemplate(name, template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy: verifyDeleted(fqrn, func(id string, request *resty.Request) (*resty.Response, error) {
+			return request.AddRetryCondition(neverRetry).Get(retentionPoliciesEndpoint + id)
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "dry_run", "true"),
+					resource.TestCheckResourceAttr(fqrn, "max_count", "5"),
+					resource.TestCheckResourceAttr(fqrn, "max_age_months", "6"),
+					resource.TestCheckResourceAttr(fqrn, "repos.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "include_patterns.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "exclude_patterns.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "dry_run", "false"),
+					resource.TestCheckResourceAttr(fqrn, "max_count", "10"),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dry_run"},
+			},
+		},
+	})
+}
@@ -24,7 +24,8 @@ func resourceArtifactorySingleReplicationConfig() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: mergeSchema(replicationSchemaCommon, replicationSchema),
+		Schema:        mergeSchema(replicationSchemaCommon, replicationSchema),
+		CustomizeDiff: syncStatisticsDiff,
 		Description: "Used for configuring replications on repos. However, the TCL only makes " +
 			"good sense for local repo replication (PUSH) and not remote (PULL).",
 		DeprecationMessage: "This resource has been deprecated in favour of the more explicitly name" +
@@ -48,7 +49,7 @@ func unpackSingleReplicationConfig(s *schema.ResourceData) *updateReplicationBod
 	replicationConfig.SyncStatistics = d.getBool("sync_statistics", false)
 	replicationConfig.PathPrefix = d.getString("path_prefix", false)
 	replicationConfig.Proxy = handleResetWithNonExistantValue(d, "proxy")
-	replicationConfig.Password = d.getString("password", false)
+	replicationConfig.Password = replicationPassword(s, "password")
 
 	return replicationConfig
 }
@@ -63,10 +64,10 @@ func packPushReplicationBody(config getReplicationBody, d *schema.ResourceData)
 	setValue("url", config.URL)
 	setValue("socket_timeout_millis", config.SocketTimeoutMillis)
 	setValue("username", config.Username)
-	// the password coming back from artifactory is already scrambled, and I don't know in what form.
+	// the password only comes back in clear when password encryption is turned off, otherwise it's scrambled
 	// password -> JE2fNsEThvb1buiH7h7S2RDsGWSdp2EcuG9Pky5AFyRMwE4UzG
-	// Because it comes back scrambled, we can't/shouldn't touch it.
-	setValue("password", config.Password)
+	// so the hash of the configured one is kept instead
+	setValue("password", replicationPasswordState(d, "password"))
 	setValue("enabled", config.Enabled)
 	setValue("sync_deletes", config.SyncDeletes)
 	setValue("sync_properties", config.SyncProperties)
@@ -83,8 +84,25 @@ func packPushReplicationBody(config getReplicationBody, d *schema.ResourceData)
 	return nil
 }
 
+// checkReplicationRepoExists is called before creating a replication, since Artifactory's own error about a missing
+// repository doesn't tell much. The repository is typically created in the same apply, without a dependency on it
+func checkReplicationRepoExists(client *resty.Client, repoKey string) diag.Diagnostics {
+	resp, err := checkRepo(repoKey, client.R().AddRetryCondition(neverRetry))
+	if err != nil {
+		if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+			return diag.Errorf("repository %s does not exist; create it first (use depends_on)", repoKey)
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
 func resourceSingleReplicationConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	replicationConfig := unpackSingleReplicationConfig(d)
+	if diags := checkReplicationRepoExists(m.(*resty.Client), replicationConfig.RepoKey); diags != nil {
+		return diags
+	}
 	// The password is sent clear
 	_, err := m.(*resty.Client).R().SetBody(replicationConfig).Put(replicationEndpoint + replicationConfig.RepoKey)
 	if err != nil {
@@ -104,6 +104,47 @@ func TestAccSingleReplication_full(t *testing.T) {
 	})
 }
 
+func TestAccSingleReplicationToggleEnabled(t *testing.T) {
+	_, fqrn, name := mkNames("lib-local", "artifactory_single_replication_config")
+	const template = `
+		resource "artifactory_local_repository" "%[1]s" {
+			key = "%[1]s"
+			package_type = "maven"
+		}
+
+		resource "artifactory_single_replication_config" "%[1]s" {
+			repo_key = artifactory_local_repository.%[1]s.key
+			cron_exp = "0 0 * * * ?"
+			url      = "%[2]s"
+			username = "%[3]s"
+			%[4]s
+		}
+	`
+	config := func(enabled string) string {
+		return fmt.Sprintf(template, name, os.Getenv("ARTIFACTORY_URL"), os.Getenv("ARTIFACTORY_USERNAME"), enabled)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckReplicationDestroy(fqrn),
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+				Check:  resource.TestCheckResourceAttr(fqrn, "enabled", "true"),
+			},
+			{
+				Config: config("enabled = false"),
+				Check:  resource.TestCheckResourceAttr(fqrn, "enabled", "false"),
+			},
+			{
+				Config: config("enabled = true"),
+				Check:  resource.TestCheckResourceAttr(fqrn, "enabled", "true"),
+			},
+		},
+	})
+}
+
 func TestAccSingleReplication_withDelRepo(t *testing.T) {
 	_, fqrn, name := mkNames("lib-local", "artifactory_single_replication_config")
 	config := mkTclForRepConfg(name, "0 0 * * * ?", os.Getenv("ARTIFACTORY_URL"), "")
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,6 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// userNames caches the names of the users of each configured client, i.e. of the provider meta, so that refreshing a
+// large number of users lists them once instead of checking each one. A provider process only lives for one terraform
+// command, and the users created or deleted through it are kept up to date
+var userNames = struct {
+	sync.Mutex
+	byClient map[*resty.Client]map[string]bool
+}{byClient: map[*resty.Client]map[string]bool{}}
+
 type User struct {
 	Name                     string   `json:"name"`
 	Email                    string   `json:"email"`
@@ -116,6 +125,11 @@ func resourceUserExists(data *schema.ResourceData, m interface{}) (bool, error)
 }
 
 func userExists(client *resty.Client, userName string) (bool, error) {
+	if names, err := cachedUserNames(client); err == nil {
+		return names[userName], nil
+	}
+
+	// listing the users may not be allowed, check the single user instead
 	resp, err := client.R().Head("artifactory/api/security/users/" + userName)
 	if err != nil && resp != nil && resp.StatusCode() == http.StatusNotFound {
 		// Do not error on 404s as this causes errors when the upstream user has been manually removed
@@ -125,6 +139,51 @@ func userExists(client *resty.Client, userName string) (bool, error) {
 	return err == nil, err
 }
 
+// cachedUserNames returns the names of all the users, listed on first use. A failure to list them is kept too, so that
+// it isn't tried again for every user
+func cachedUserNames(client *resty.Client) (map[string]bool, error) {
+	userNames.Lock()
+	defer userNames.Unlock()
+
+	if names, ok := userNames.byClient[client]; ok {
+		if names == nil {
+			return nil, fmt.Errorf("the users couldn't be listed")
+		}
+		return names, nil
+	}
+
+	var users []struct {
+		Name string `json:"name"`
+	}
+	_, err := client.R().SetResult(&users).AddRetryCondition(neverRetry).Get("artifactory/api/security/users")
+	if err != nil {
+		userNames.byClient[client] = nil
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(users))
+	for _, user := range users {
+		names[user.Name] = true
+	}
+	userNames.byClient[client] = names
+
+	return names, nil
+}
+
+// setCachedUserName records a user created or deleted through the client, if its users have been listed
+func setCachedUserName(client *resty.Client, userName string, exists bool) {
+	userNames.Lock()
+	defer userNames.Unlock()
+
+	if names := userNames.byClient[client]; names != nil {
+		if exists {
+			names[userName] = true
+		} else {
+			delete(names, userName)
+		}
+	}
+}
+
 func unpackUser(s *schema.ResourceData) User {
 	d := &ResourceData{s}
 	return User{
@@ -175,6 +234,7 @@ func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
+	setCachedUserName(m.(*resty.Client), user.Name, true)
 
 	d.SetId(user.Name)
 	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
@@ -229,5 +289,6 @@ func resourceUserDelete(rd *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("user %s not deleted. %s", userName, err)
 	}
+	setCachedUserName(m.(*resty.Client), userName, false)
 	return nil
 }
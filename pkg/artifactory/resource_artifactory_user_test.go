@@ -1,8 +1,10 @@
 package artifactory
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
@@ -104,6 +106,43 @@ func TestAccUser_full(t *testing.T) {
 	})
 }
 
+func TestUserExistsListsUsersOnce(t *testing.T) {
+	listed := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/security/users" {
+			t.Errorf("expected only the users to be listed, got %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		listed++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]string{{"name": "admin"}, {"name": "the.dude"}})
+	}))
+	defer server.Close()
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for userName, expected := range map[string]bool{"admin": true, "the.dude": true, "walter": false} {
+		exists, err := userExists(client, userName)
+		if err != nil || exists != expected {
+			t.Errorf("expected user %s to exist: %v, got %v (%v)", userName, expected, exists, err)
+		}
+	}
+	setCachedUserName(client, "walter", true)
+	setCachedUserName(client, "the.dude", false)
+	if exists, _ := userExists(client, "walter"); !exists {
+		t.Error("expected a created user to exist")
+	}
+	if exists, _ := userExists(client, "the.dude"); exists {
+		t.Error("expected a deleted user not to exist")
+	}
+	if listed != 1 {
+		t.Errorf("expected the users to be listed once, got %d", listed)
+	}
+}
+
 func testAccCheckUserDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		provider, _ := testAccProviders["artifactory"]()
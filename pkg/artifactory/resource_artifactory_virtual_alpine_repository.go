@@ -0,0 +1,47 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var alpineVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "alpine"), map[string]*schema.Schema{
+	"primary_keypair_ref": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Used to sign the index files aggregated from the members of the virtual repository. " +
+			"See: https://www.jfrog.com/confluence/display/JFROG/Alpine+Linux+Repositories#AlpineLinuxRepositories-SigningAlpineLinuxIndex",
+	},
+})
+
+type AlpineVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+	PrimaryKeyPairRef string `hcl:"primary_keypair_ref" json:"primaryKeyPairRef"`
+}
+
+func resourceArtifactoryAlpineVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(alpineVirtualSchema, defaultPacker, unpackAlpineVirtualRepository, func() interface{} {
+		return &AlpineVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "alpine",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(
+		resource.CustomizeDiff,
+		mkRepositoriesPackageTypeDiff("alpine"),
+		mkKeyPairExistsDiff("primary_keypair_ref"),
+	)
+
+	return resource
+}
+
+func unpackAlpineVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := AlpineVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "alpine"),
+		PrimaryKeyPairRef:           d.getString("primary_keypair_ref", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,66 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var bowerVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "bower"), map[string]*schema.Schema{
+	"external_dependencies_enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set, external dependencies are rewritten. Default value is 'false'.",
+	},
+	"external_dependencies_patterns": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		RequiredWith: []string{"external_dependencies_enabled"},
+		Description: "An Allow List of Ant-style path expressions that specify where external dependencies may be downloaded from. " +
+			"By default, this is set to ** which means that dependencies may be downloaded from any external source.",
+	},
+	"external_dependencies_remote_repo": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: repoKeyValidator,
+		RequiredWith: []string{"external_dependencies_enabled"},
+		Description:  "The remote repository aggregated by this virtual repository in which the external dependency will be cached.",
+	},
+})
+
+type BowerVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+	ExternalDependenciesEnabled    bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled"`
+	ExternalDependenciesPatterns   []string `hcl:"external_dependencies_patterns" json:"externalDependenciesPatterns,omitempty"`
+	ExternalDependenciesRemoteRepo string   `hcl:"external_dependencies_remote_repo" json:"externalDependenciesRemoteRepo,omitempty"`
+}
+
+func resourceArtifactoryBowerVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(bowerVirtualSchema, defaultPacker, unpackBowerVirtualRepository, func() interface{} {
+		return &BowerVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "bower",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("bower"))
+
+	return resource
+}
+
+func unpackBowerVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := BowerVirtualRepositoryParams{
+		VirtualRepositoryBaseParams:    unpackBaseVirtRepo(s, "bower"),
+		ExternalDependenciesEnabled:    d.getBool("external_dependencies_enabled", false),
+		ExternalDependenciesPatterns:   d.getList("external_dependencies_patterns"),
+		ExternalDependenciesRemoteRepo: d.getString("external_dependencies_remote_repo", false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,43 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var cargoVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "cargo"), map[string]*schema.Schema{
+	"enable_sparse_index": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Enable internal index support based on Cargo sparse index specifications, instead of the default git index. Default value is 'false'.",
+	},
+})
+
+type CargoVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+	EnableSparseIndex bool `hcl:"enable_sparse_index" json:"cargoInternalIndex"`
+}
+
+func resourceArtifactoryCargoVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(cargoVirtualSchema, defaultPacker, unpackCargoVirtualRepository, func() interface{} {
+		return &CargoVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "cargo",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("cargo"))
+
+	return resource
+}
+
+func unpackCargoVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := CargoVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "cargo"),
+		EnableSparseIndex:           d.getBool("enable_sparse_index", false),
+	}
+	return repo, repo.Id(), nil
+}
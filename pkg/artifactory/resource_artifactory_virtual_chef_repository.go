@@ -0,0 +1,33 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var chefVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "chef"))
+
+type ChefVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+}
+
+func resourceArtifactoryChefVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(chefVirtualSchema, defaultPacker, unpackChefVirtualRepository, func() interface{} {
+		return &ChefVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "chef",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("chef"))
+
+	return resource
+}
+
+func unpackChefVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	repo := ChefVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "chef"),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,42 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var conanVirtualSchema = mergeSchema(
+	repoWithRetrivalCachePeriodSecsVirtualSchema,
+	conanForceAuth.Schema,
+	repoLayoutRefSchema("virtual", "conan"),
+)
+
+type ConanVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParamsWithRetrievalCachePeriodSecs
+	ForceConanAuthentication bool `hcl:"force_conan_authentication" json:"forceConanAuthentication"`
+}
+
+func resourceArtifactoryConanVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(conanVirtualSchema, defaultPacker, unpackConanVirtualRepository, func() interface{} {
+		return &ConanVirtualRepositoryParams{
+			VirtualRepositoryBaseParamsWithRetrievalCachePeriodSecs: VirtualRepositoryBaseParamsWithRetrievalCachePeriodSecs{
+				VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+					Rclass:      "virtual",
+					PackageType: "conan",
+				},
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("conan"))
+
+	return resource
+}
+
+func unpackConanVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := ConanVirtualRepositoryParams{
+		VirtualRepositoryBaseParamsWithRetrievalCachePeriodSecs: unpackBaseVirtRepoWithRetrievalCachePeriodSecs(s, "conan"),
+		ForceConanAuthentication:                                d.getBool(conanForceAuth.Attribute, false),
+	}
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,25 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var condaVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "conda"))
+
+func resourceArtifactoryCondaVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(condaVirtualSchema, defaultPacker, unpackCondaVirtualRepository, func() interface{} {
+		return &VirtualRepositoryBaseParams{
+			Rclass:      "virtual",
+			PackageType: "conda",
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("conda"))
+
+	return resource
+}
+
+func unpackCondaVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	repo := unpackBaseVirtRepo(s, "conda")
+	return repo, repo.Id(), nil
+}
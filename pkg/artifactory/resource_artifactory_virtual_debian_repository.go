@@ -0,0 +1,70 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var debianVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "debian"), map[string]*schema.Schema{
+	"primary_keypair_ref": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "Primary keypair used to sign the aggregated index files.",
+	},
+	"secondary_keypair_ref": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "Secondary keypair used to sign the aggregated index files.",
+	},
+	"debian_default_architectures": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "amd64,i386",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "Comma separated list of the architectures indexed by default. Default value is 'amd64,i386'.",
+	},
+}, compressionFormats)
+
+type DebianVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+	CommonRpmDebianVirtualRepositoryParams
+	IndexCompressionFormats    []string `hcl:"index_compression_formats" json:"optionalIndexCompressionFormats,omitempty"`
+	DebianDefaultArchitectures string   `hcl:"debian_default_architectures" json:"debianDefaultArchitectures"`
+}
+
+func resourceArtifactoryDebianVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(debianVirtualSchema, defaultPacker, unpackDebianVirtualRepository, func() interface{} {
+		return &DebianVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "debian",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(
+		resource.CustomizeDiff,
+		mkRepositoriesPackageTypeDiff("debian"),
+		mkKeyPairExistsDiff("primary_keypair_ref", "secondary_keypair_ref"),
+	)
+
+	return resource
+}
+
+func unpackDebianVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+
+	repo := DebianVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "debian"),
+		CommonRpmDebianVirtualRepositoryParams: CommonRpmDebianVirtualRepositoryParams{
+			PrimaryKeyPairRef:   d.getString("primary_keypair_ref", false),
+			SecondaryKeyPairRef: d.getString("secondary_keypair_ref", false),
+		},
+		IndexCompressionFormats:    d.getSet("index_compression_formats"),
+		DebianDefaultArchitectures: d.getString("debian_default_architectures", false),
+	}
+
+	return &repo, repo.Key, nil
+}
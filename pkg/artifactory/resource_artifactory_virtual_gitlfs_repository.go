@@ -0,0 +1,33 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitlfsVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "gitlfs"))
+
+type GitLfsVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+}
+
+func resourceArtifactoryGitLfsVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(gitlfsVirtualSchema, defaultPacker, unpackGitLfsVirtualRepository, func() interface{} {
+		return &GitLfsVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "gitlfs",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("gitlfs"))
+
+	return resource
+}
+
+func unpackGitLfsVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	repo := GitLfsVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "gitlfs"),
+	}
+	return repo, repo.Id(), nil
+}
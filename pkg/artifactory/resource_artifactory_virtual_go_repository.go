@@ -1,9 +1,16 @@
 package artifactory
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// goExternalDependenciesPatternsDefault is what Artifactory falls back to when 'go-import' meta tags are followed without an allow list
+var goExternalDependenciesPatternsDefault = []string{"**"}
+
 type GoVirtualRepositoryParams struct {
 	VirtualRepositoryBaseParams
 	ExternalDependenciesEnabled  bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled,omitempty"`
@@ -21,9 +28,11 @@ var goVirtualSchema = mergeSchema(baseVirtualRepoSchema, map[string]*schema.Sche
 	"external_dependencies_patterns": {
 		Type:     schema.TypeList,
 		Optional: true,
+		Computed: true,
 		ForceNew: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
 		},
 		RequiredWith: []string{"external_dependencies_enabled"},
 		Description: "An allow list of Ant-style path patterns that determine which remote VCS roots Artifactory will " +
@@ -33,7 +42,7 @@ var goVirtualSchema = mergeSchema(baseVirtualRepoSchema, map[string]*schema.Sche
 })
 
 func resourceArtifactoryGoVirtualRepository() *schema.Resource {
-	return mkResourceSchema(goVirtualSchema, defaultPacker, unpackGoVirtualRepository, func() interface{} {
+	resource := mkResourceSchema(goVirtualSchema, defaultPacker, unpackGoVirtualRepository, func() interface{} {
 		return &GoVirtualRepositoryParams{
 			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
 				Rclass:      "virtual",
@@ -41,7 +50,23 @@ func resourceArtifactoryGoVirtualRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, goExternalDependenciesPatternsDiff)
+
+	return resource
+}
 
+// goExternalDependenciesPatternsDiff makes sure the allow list isn't empty when 'go-import' meta tags are followed, e.g.
+// once they are enabled on a repository which had no allow list. An empty list can't be told apart from an unset one,
+// so it is planned as the '**' default rather than rejected. The list isn't checked when the meta tags aren't followed
+func goExternalDependenciesPatternsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("external_dependencies_enabled") || !diff.Get("external_dependencies_enabled").(bool) {
+		return nil
+	}
+	if !diff.NewValueKnown("external_dependencies_patterns") || len(diff.Get("external_dependencies_patterns").([]interface{})) > 0 {
+		return nil
+	}
+
+	return diff.SetNew("external_dependencies_patterns", goExternalDependenciesPatternsDefault)
 }
 
 func unpackGoVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
@@ -53,5 +78,8 @@ func unpackGoVirtualRepository(s *schema.ResourceData) (interface{}, string, err
 		ExternalDependenciesEnabled:  d.getBool("external_dependencies_enabled", false),
 	}
 	repo.PackageType = "go"
+	if repo.ExternalDependenciesEnabled && len(repo.ExternalDependenciesPatterns) == 0 {
+		repo.ExternalDependenciesPatterns = goExternalDependenciesPatternsDefault
+	}
 	return &repo, repo.Key, nil
 }
@@ -55,17 +55,40 @@ func resourceArtifactoryMavenVirtualRepository() *schema.Resource {
 }
 
 func unpackMavenVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	return unpackJavaVirtualRepository(s, "maven")
+}
+
+// resourceArtifactoryJavaVirtualRepository is the Maven virtual repository for the other Java build tools, which
+// default to the layout of their package type instead of the one computed by Artifactory
+func resourceArtifactoryJavaVirtualRepository(packageType string) *schema.Resource {
+	javaVirtualSchema := mergeSchema(mavenVirtualSchema, repoLayoutRefSchema("virtual", packageType))
+
+	unpack := func(s *schema.ResourceData) (interface{}, string, error) {
+		return unpackJavaVirtualRepository(s, packageType)
+	}
+
+	return mkResourceSchema(javaVirtualSchema, defaultPacker, unpack, func() interface{} {
+		return &MavenVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: packageType,
+			},
+		}
+	})
+}
+
+func unpackJavaVirtualRepository(s *schema.ResourceData, packageType string) (interface{}, string, error) {
 	d := &ResourceData{s}
 
 	repo := MavenVirtualRepositoryParams{
-		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "maven"),
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, packageType),
 		CommonMavenGradleVirtualRepositoryParams: CommonMavenGradleVirtualRepositoryParams{
 			KeyPair:                              d.getString("key_pair", false),
 			ForceMavenAuthentication:             d.getBool("force_maven_authentication", false),
 			PomRepositoryReferencesCleanupPolicy: d.getString("pom_repository_references_cleanup_policy", false),
 		},
 	}
-	repo.PackageType = "maven"
+	repo.PackageType = packageType
 
 	return &repo, repo.Key, nil
 }
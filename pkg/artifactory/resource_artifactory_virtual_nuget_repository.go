@@ -0,0 +1,38 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var nugetVirtualSchema = mergeSchema(baseVirtualRepoSchema, nugetForceAuth.Schema, repoLayoutRefSchema("virtual", "nuget"))
+
+type NugetVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+	ForceNugetAuthentication bool `hcl:"force_nuget_authentication" json:"forceNugetAuthentication"`
+}
+
+func resourceArtifactoryNugetVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(nugetVirtualSchema, defaultPacker, unpackNugetVirtualRepository, func() interface{} {
+		return &NugetVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "nuget",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("nuget"))
+
+	return resource
+}
+
+func unpackNugetVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+
+	repo := NugetVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "nuget"),
+		ForceNugetAuthentication:    d.getBool(nugetForceAuth.Attribute, false),
+	}
+
+	return repo, repo.Id(), nil
+}
@@ -0,0 +1,26 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var p2VirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "p2"))
+
+func resourceArtifactoryP2VirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(p2VirtualSchema, defaultPacker, unpackP2VirtualRepository, func() interface{} {
+		return &VirtualRepositoryBaseParams{
+			Rclass:      "virtual",
+			PackageType: "p2",
+		}
+	})
+	// there are no local p2 repositories, p2 virtual repositories aggregate generic and maven local ones
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("p2", "generic", "maven"))
+
+	return resource
+}
+
+func unpackP2VirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	repo := unpackBaseVirtRepo(s, "p2")
+	return repo, repo.Id(), nil
+}
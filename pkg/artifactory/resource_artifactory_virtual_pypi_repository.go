@@ -0,0 +1,26 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pypiVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "pypi"))
+
+func resourceArtifactoryPypiVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(pypiVirtualSchema, defaultPacker, unpackPypiVirtualRepository, func() interface{} {
+		return &VirtualRepositoryBaseParams{
+			Rclass:      "virtual",
+			PackageType: "pypi",
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("pypi"))
+
+	return resource
+}
+
+func unpackPypiVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	repo := unpackBaseVirtRepo(s, "pypi")
+
+	return repo, repo.Id(), nil
+}
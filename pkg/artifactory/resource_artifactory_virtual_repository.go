@@ -99,16 +99,17 @@ func resourceArtifactoryVirtualGenericRepository(pkt string) *schema.Resource {
 	return mkResourceSchema(baseVirtualRepoSchema, defaultPacker, unpack, constructor)
 }
 
+var repoWithRetrivalCachePeriodSecsVirtualSchema = mergeSchema(baseVirtualRepoSchema, map[string]*schema.Schema{
+	"retrieval_cache_period_seconds": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      7200,
+		Description:  "This value refers to the number of seconds to cache metadata files before checking for newer versions on aggregated repositories. A value of 0 indicates no caching.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+})
+
 func resourceArtifactoryVirtualRepositoryWithRetrievalCachePeriodSecs(pkt string) *schema.Resource {
-	var repoWithRetrivalCachePeriodSecsVirtualSchema = mergeSchema(baseVirtualRepoSchema, map[string]*schema.Schema{
-		"retrieval_cache_period_seconds": {
-			Type:         schema.TypeInt,
-			Optional:     true,
-			Default:      7200,
-			Description:  "This value refers to the number of seconds to cache metadata files before checking for newer versions on aggregated repositories. A value of 0 indicates no caching.",
-			ValidateFunc: validation.IntAtLeast(0),
-		},
-	})
 	constructor := func() interface{} {
 		return &VirtualRepositoryBaseParamsWithRetrievalCachePeriodSecs{
 			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
@@ -124,21 +125,11 @@ func resourceArtifactoryVirtualRepositoryWithRetrievalCachePeriodSecs(pkt string
 	return mkResourceSchema(repoWithRetrivalCachePeriodSecsVirtualSchema, defaultPacker, unpack, constructor)
 }
 
-type DebianVirtualRepositoryParams struct {
-	VirtualRepositoryBaseParams
-	DebianTrivialLayout *bool `json:"debianTrivialLayout,omitempty"`
-}
-
-type NugetVirtualRepositoryParams struct {
-	VirtualRepositoryBaseParams
-	ForceNugetAuthentication *bool `json:"forceNugetAuthentication,omitempty"`
-}
-
 type MessyVirtualRepo struct {
 	VirtualRepositoryBaseParams
-	DebianVirtualRepositoryParams
 	MavenVirtualRepositoryParams
-	NugetVirtualRepositoryParams
+	DebianTrivialLayout      *bool `json:"debianTrivialLayout,omitempty"`
+	ForceNugetAuthentication *bool `json:"forceNugetAuthentication,omitempty"`
 }
 
 func unpackVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
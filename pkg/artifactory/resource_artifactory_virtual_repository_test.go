@@ -1,15 +1,22 @@
 package artifactory
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccVirtualRepository_basic(t *testing.T) {
@@ -107,6 +114,119 @@ func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 	})
 }
 
+func TestAccVirtualRepository_change_default_deployment_repo(t *testing.T) {
+	id := randomInt()
+	name := fmt.Sprintf("foo%d", id)
+	fqrn := fmt.Sprintf("artifactory_virtual_maven_repository.%s", name)
+	const virtualRepositoryWithDefaultDeploymentRepo = `
+		resource "artifactory_local_maven_repository" "%[1]s-a" {
+			key = "%[1]s-a"
+		}
+
+		resource "artifactory_local_maven_repository" "%[1]s-b" {
+			key = "%[1]s-b"
+		}
+
+		resource "artifactory_virtual_maven_repository" "%[1]s" {
+			key                     = "%[1]s"
+			repositories            = [%[2]s]
+			default_deployment_repo = "%[1]s-%[3]s"
+			depends_on              = [artifactory_local_maven_repository.%[1]s-a, artifactory_local_maven_repository.%[1]s-b]
+		}
+	`
+	var created string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(virtualRepositoryWithDefaultDeploymentRepo, name, fmt.Sprintf(`"%[1]s-a", "%[1]s-b"`, name), "a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "default_deployment_repo", name+"-a"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", name+"-a"),
+					func(s *terraform.State) error {
+						created = s.RootModule().Resources[fqrn].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(virtualRepositoryWithDefaultDeploymentRepo, name, fmt.Sprintf(`"%[1]s-b", "%[1]s-a"`, name), "b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "default_deployment_repo", name+"-b"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", name+"-b"),
+					resource.TestCheckResourceAttrPtr(fqrn, "id", &created),
+				),
+			},
+		},
+	})
+}
+
+func TestVirtualRepositoryDefaultDeploymentRepoUpdatesInPlace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"rclass": "local", "packageType": "maven"})
+	}))
+	defer server.Close()
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := resourceArtifactoryMavenVirtualRepository()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"key":                     "maven-virtual",
+		"repositories":            []interface{}{"maven-local-a", "maven-local-b"},
+		"default_deployment_repo": "maven-local-a",
+	})
+	d.SetId("maven-virtual")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":                     "maven-virtual",
+		"repositories":            []interface{}{"maven-local-b", "maven-local-a"},
+		"default_deployment_repo": "maven-local-b",
+	})
+	diff, err := res.Diff(context.Background(), d.State(), config, client.SetRetryCount(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["default_deployment_repo"] == nil || diff.Attributes["repositories.0"] == nil {
+		t.Fatalf("expected default_deployment_repo and repositories to change, got %v", diff)
+	}
+	if diff.RequiresNew() {
+		t.Errorf("changing default_deployment_repo and repositories must not replace the repository: %v", diff)
+	}
+}
+
+func TestVirtualRepositoryDefaultDeploymentRepoWarning(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "generic-virtual")
+	repo.responses["api/repositories/generic-local"] = map[string]interface{}{"rclass": "local", "packageType": "generic"}
+	repo.responses["api/repositories/generic-remote"] = map[string]interface{}{"rclass": "remote", "packageType": "generic"}
+
+	res := resourceArtifactoryVirtualGenericRepository("generic")
+	for _, config := range []struct {
+		attributes map[string]interface{}
+		warned     bool
+	}{
+		{map[string]interface{}{"repositories": []interface{}{"generic-remote", "generic-local"}}, true},
+		{map[string]interface{}{"repositories": []interface{}{"generic-remote", "generic-local"}, "default_deployment_repo": "generic-local"}, false},
+		{map[string]interface{}{"repositories": []interface{}{"generic-remote"}}, false},
+	} {
+		config.attributes["key"] = "generic-virtual"
+		d := schema.TestResourceDataRaw(t, res.Schema, config.attributes)
+		diags := res.CreateContext(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatalf("failed to create the repository: %v", diags)
+		}
+		warned := len(diags) == 1 && diags[0].Severity == diag.Warning && strings.Contains(diags[0].Summary, "default_deployment_repo")
+		if warned != config.warned || (!config.warned && len(diags) > 0) {
+			t.Errorf("expected a default_deployment_repo warning to be %v for %v, got %v", config.warned, config.attributes, diags)
+		}
+	}
+}
+
 func TestAccVirtualGoRepository_basic(t *testing.T) {
 	_, fqrn, name := mkNames("foo", "artifactory_virtual_go_repository")
 	var virtualRepositoryBasic = fmt.Sprintf(`
@@ -148,6 +268,93 @@ func TestAccVirtualGoRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualGoRepository_defaultExternalDependenciesPatterns(t *testing.T) {
+	_, fqrn, name := mkNames("foo", "artifactory_virtual_go_repository")
+	localRepoName := fmt.Sprintf("%s-local", name)
+	remoteRepoName := fmt.Sprintf("%s-remote", name)
+	var virtualRepositoryWithMembers = fmt.Sprintf(`
+		resource "artifactory_local_repository" "%[2]s" {
+		  key          = "%[2]s"
+		  package_type = "go"
+		}
+
+		resource "artifactory_remote_repository" "%[3]s" {
+		  key          = "%[3]s"
+		  package_type = "go"
+		  url          = "https://proxy.golang.org/"
+		}
+
+		resource "artifactory_virtual_go_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = [
+			artifactory_local_repository.%[2]s.key,
+			artifactory_remote_repository.%[3]s.key,
+		  ]
+		  external_dependencies_enabled = true
+		}
+	`, name, localRepoName, remoteRepoName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: virtualRepositoryWithMembers,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.0", "**"),
+				),
+			},
+		},
+	})
+}
+
+func TestVirtualGoRepositoryExternalDependenciesPatternsDiff(t *testing.T) {
+	goVirtual := resourceArtifactoryGoVirtualRepository()
+
+	// following the meta tags of a repository which didn't
+	state := &terraform.InstanceState{
+		ID: "go-virtual",
+		Attributes: map[string]string{
+			"id":                               "go-virtual",
+			"key":                              "go-virtual",
+			"package_type":                     "go",
+			"external_dependencies_enabled":    "false",
+			"external_dependencies_patterns.#": "0",
+		},
+	}
+	diff, err := goVirtual.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":                           "go-virtual",
+		"external_dependencies_enabled": true,
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pattern := diff.Attributes["external_dependencies_patterns.0"]; pattern == nil || pattern.New != "**" {
+		t.Errorf("expected the patterns to be planned as the ** default, got %v", diff.Attributes)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":                            "go-virtual",
+		"external_dependencies_enabled":  false,
+		"external_dependencies_patterns": []interface{}{},
+	})
+	if diags := goVirtual.Validate(config); diags.HasError() {
+		t.Errorf("expected no patterns to be accepted when external dependencies are disabled, got %v", diags)
+	}
+	diff, err = goVirtual.Diff(context.Background(), &terraform.InstanceState{}, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := diff.Attributes["external_dependencies_patterns.0"]; ok {
+		t.Errorf("expected no default patterns when external dependencies are disabled, got %v", diff.Attributes)
+	}
+}
+
 func TestAccVirtualConanRepository_basic(t *testing.T) {
 	_, fqrn, name := mkNames("foo", "artifactory_virtual_conan_repository")
 	var virtualRepositoryBasic = fmt.Sprintf(`
@@ -160,6 +367,7 @@ func TestAccVirtualConanRepository_basic(t *testing.T) {
 		  includes_pattern = "com/jfrog/**,cloud/jfrog/**"
 		  excludes_pattern = "com/google/**"
  		  retrieval_cache_period_seconds = 7100
+		  force_conan_authentication = true
 		}
 	`, name, name)
 
@@ -176,6 +384,33 @@ func TestAccVirtualConanRepository_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "key", name),
 					resource.TestCheckResourceAttr(fqrn, "package_type", "conan"),
 					resource.TestCheckResourceAttr(fqrn, "retrieval_cache_period_seconds", "7100"),
+					resource.TestCheckResourceAttr(fqrn, "force_conan_authentication", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualConanRepository_defaults(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-conan-repo", "artifactory_virtual_conan_repository")
+	const template = `
+		resource "artifactory_virtual_conan_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = []
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "conan-default"),
+					resource.TestCheckResourceAttr(fqrn, "force_conan_authentication", "false"),
 				),
 			},
 		},
@@ -254,6 +489,99 @@ func TestAccVirtualMavenRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualJavaRepositories(t *testing.T) {
+	for _, packageType := range []string{"sbt", "ivy"} {
+		t.Run(packageType, func(t *testing.T) {
+			_, fqrn, name := mkNames(fmt.Sprintf("virtual-%s-repo", packageType), fmt.Sprintf("artifactory_virtual_%s_repository", packageType))
+			config := fmt.Sprintf(`
+				resource "artifactory_virtual_%s_repository" "%s" {
+					key                                      = "%s"
+					repositories                             = []
+					force_maven_authentication               = true
+					pom_repository_references_cleanup_policy = "nothing"
+				}
+			`, packageType, name, name)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+				ProviderFactories: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(fqrn, "key", name),
+							resource.TestCheckResourceAttr(fqrn, "package_type", packageType),
+							resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", fmt.Sprintf("%s-default", packageType)),
+							resource.TestCheckResourceAttr(fqrn, "force_maven_authentication", "true"),
+							resource.TestCheckResourceAttr(fqrn, "pom_repository_references_cleanup_policy", "nothing"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccVirtualTerraformRepository(t *testing.T) {
+	for _, terraformType := range []string{"module", "provider"} {
+		t.Run(terraformType, func(t *testing.T) {
+			_, fqrn, name := mkNames("virtual-terraform-repo", "artifactory_virtual_terraform_repository")
+			config := fmt.Sprintf(`
+				resource "artifactory_virtual_terraform_repository" "%[1]s" {
+					key            = "%[1]s"
+					terraform_type = "%[2]s"
+					repositories   = []
+				}
+			`, name, terraformType)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+				ProviderFactories: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(fqrn, "key", name),
+							resource.TestCheckResourceAttr(fqrn, "package_type", "terraform"),
+							resource.TestCheckResourceAttr(fqrn, "terraform_type", terraformType),
+							resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", fmt.Sprintf("terraform-%s-default", terraformType)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestVirtualTerraformRepositoryMembersDiff(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "tf-virtual")
+	repo.responses["api/repositories/tf-modules-local"] = map[string]interface{}{"rclass": "local", "packageType": "terraform", "terraformType": "module"}
+	repo.responses["api/repositories/tf-providers-local"] = map[string]interface{}{"rclass": "local", "packageType": "terraform", "terraformType": "provider"}
+	repo.responses["api/repositories/tf-registry-remote"] = map[string]interface{}{"rclass": "remote", "packageType": "terraform"}
+	repo.responses["api/repositories/generic-local"] = map[string]interface{}{"rclass": "local", "packageType": "generic"}
+
+	terraformVirtual := resourceArtifactoryTerraformVirtualRepository()
+	for members, expectedError := range map[string]string{
+		"tf-modules-local,tf-registry-remote": "",
+		"tf-providers-local":                  "repository tf-providers-local holds Terraform providers, only module repositories can be included",
+		"generic-local":                       "repository generic-local has package type generic, only terraform repositories can be included",
+	} {
+		_, err := terraformVirtual.Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"key":            "tf-virtual",
+			"terraform_type": "module",
+			"repositories":   castToInterfaceArr(strings.Split(members, ",")),
+		}), client)
+		if expectedError == "" && err != nil {
+			t.Errorf("expected %s to be accepted, got %s", members, err)
+		}
+		if expectedError != "" && (err == nil || !strings.Contains(err.Error(), expectedError)) {
+			t.Errorf("expected %s to be rejected with %q, got %v", members, expectedError, err)
+		}
+	}
+}
+
 func TestAccVirtualHelmRepository_basic(t *testing.T) {
 	_, fqrn, name := mkNames("virtual-helm-repo", "artifactory_virtual_helm_repository")
 	useNamespaces := randBool()
@@ -287,6 +615,479 @@ func TestAccVirtualHelmRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNugetRepository_basic(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-nuget-repo", "artifactory_virtual_nuget_repository")
+	var virtualRepositoryBasic = fmt.Sprintf(`
+		resource "artifactory_virtual_nuget_repository" "%s" {
+		  key                        = "%s"
+		  repositories               = []
+		  description                = "A test virtual repo"
+		  notes                      = "Internal description"
+		  force_nuget_authentication = true
+		}
+	`, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: virtualRepositoryBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "nuget"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "nuget-default"),
+					resource.TestCheckResourceAttr(fqrn, "force_nuget_authentication", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualNugetRepositoryWithInvalidMember(t *testing.T) {
+	_, localFqrn, localName := mkNames("npm-local", "artifactory_local_npm_repository")
+	_, _, name := mkNames("virtual-nuget-repo", "artifactory_virtual_nuget_repository")
+	localRepository := fmt.Sprintf(`
+		resource "artifactory_local_npm_repository" "%s" {
+		  key = "%s"
+		}
+	`, localName, localName)
+	virtualRepository := localRepository + fmt.Sprintf(`
+		resource "artifactory_virtual_nuget_repository" "%s" {
+		  key          = "%s"
+		  repositories = ["%s"]
+		}
+	`, name, name, localName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(localFqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: localRepository,
+			},
+			{
+				Config:      virtualRepository,
+				ExpectError: regexp.MustCompile(".*has package type npm, only nuget repositories can be included.*"),
+			},
+		},
+	})
+}
+
+func TestAccVirtualPypiRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-pypi-repo", "artifactory_virtual_pypi_repository")
+	_, _, localName := mkNames("pypi-local", "artifactory_local_pypi_repository")
+	_, _, remoteName := mkNames("pypi-remote", "artifactory_remote_pypi_repository")
+	var virtualRepositoryBasic = fmt.Sprintf(`
+		resource "artifactory_local_pypi_repository" "%[2]s" {
+		  key = "%[2]s"
+		}
+
+		resource "artifactory_remote_pypi_repository" "%[3]s" {
+		  key = "%[3]s"
+		  url = "https://files.pythonhosted.org"
+		}
+
+		resource "artifactory_virtual_pypi_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = [
+			artifactory_local_pypi_repository.%[2]s.key,
+			artifactory_remote_pypi_repository.%[3]s.key,
+		  ]
+		  description  = "A test virtual repo"
+		  notes        = "Internal description"
+		}
+	`, name, localName, remoteName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: virtualRepositoryBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "pypi"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", localName),
+					resource.TestCheckResourceAttr(fqrn, "repositories.1", remoteName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualDebianRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-debian-repo", "artifactory_virtual_debian_repository")
+	_, _, localName := mkNames("debian-local", "artifactory_local_debian_repository")
+	var virtualRepositoryBasic = fmt.Sprintf(`
+		resource "artifactory_local_debian_repository" "%[2]s" {
+		  key = "%[2]s"
+		}
+
+		resource "artifactory_virtual_debian_repository" "%[1]s" {
+		  key                          = "%[1]s"
+		  repositories                 = [artifactory_local_debian_repository.%[2]s.key]
+		  index_compression_formats    = ["bz2", "lzma"]
+		  debian_default_architectures = "amd64,arm64"
+		}
+	`, name, localName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: virtualRepositoryBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "debian"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", localName),
+					resource.TestCheckResourceAttr(fqrn, "index_compression_formats.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "debian_default_architectures", "amd64,arm64"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualCondaRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-conda-repo", "artifactory_virtual_conda_repository")
+	const template = `
+		resource "artifactory_virtual_conda_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = []
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "conda"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "conda-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualP2Repository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-p2-repo", "artifactory_virtual_p2_repository")
+	_, _, localName := mkNames("generic-local", "artifactory_local_generic_repository")
+	const template = `
+		resource "artifactory_local_generic_repository" "%[2]s" {
+		  key = "%[2]s"
+		}
+
+		resource "artifactory_virtual_p2_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = [artifactory_local_generic_repository.%[2]s.key]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, localName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "p2"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", localName),
+				),
+			},
+			{
+				// the generic member exists by now, so the member check reads it
+				Config:   fmt.Sprintf(template, name, localName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestVirtualP2RepositoryMembersDiff(t *testing.T) {
+	client, repo := mkFakeRepositoryServer(t, "p2-virtual")
+	repo.responses["api/repositories/generic-local"] = map[string]interface{}{"rclass": "local", "packageType": "generic"}
+	repo.responses["api/repositories/maven-local"] = map[string]interface{}{"rclass": "local", "packageType": "maven"}
+	repo.responses["api/repositories/p2-remote"] = map[string]interface{}{"rclass": "remote", "packageType": "p2"}
+	repo.responses["api/repositories/npm-local"] = map[string]interface{}{"rclass": "local", "packageType": "npm"}
+
+	p2Virtual := resourceArtifactoryP2VirtualRepository()
+	for members, expectedError := range map[string]string{
+		"generic-local,maven-local,p2-remote": "",
+		"npm-local":                           "repository npm-local has package type npm, only p2, generic, maven repositories can be included",
+	} {
+		_, err := p2Virtual.Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"key":          "p2-virtual",
+			"repositories": castToInterfaceArr(strings.Split(members, ",")),
+		}), client)
+		if expectedError == "" && err != nil {
+			t.Errorf("expected %s to be accepted, got %s", members, err)
+		}
+		if expectedError != "" && (err == nil || !strings.Contains(err.Error(), expectedError)) {
+			t.Errorf("expected %s to be rejected with %q, got %v", members, expectedError, err)
+		}
+	}
+}
+
+func TestAccVirtualCargoRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-cargo-repo", "artifactory_virtual_cargo_repository")
+	_, _, remoteName := mkNames("cargo-remote", "artifactory_remote_cargo_repository")
+	const template = `
+		resource "artifactory_remote_cargo_repository" "%[2]s" {
+		  key              = "%[2]s"
+		  url              = "https://github.com/"
+		  git_registry_url = "https://github.com/rust-lang/foo.index"
+		}
+
+		resource "artifactory_virtual_cargo_repository" "%[1]s" {
+		  key                 = "%[1]s"
+		  repositories        = [%[3]s]
+		  enable_sparse_index = true
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, remoteName, fmt.Sprintf("artifactory_remote_cargo_repository.%s.key", remoteName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "cargo"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "enable_sparse_index", "true"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualSwiftRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-swift-repo", "artifactory_virtual_swift_repository")
+	_, _, remoteName := mkNames("swift-remote", "artifactory_remote_swift_repository")
+	const template = `
+		resource "artifactory_remote_swift_repository" "%[2]s" {
+		  key              = "%[2]s"
+		  url              = "https://github.com/"
+		  vcs_git_provider = "GITHUB"
+		}
+
+		resource "artifactory_virtual_swift_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = [%[3]s]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, remoteName, fmt.Sprintf("artifactory_remote_swift_repository.%s.key", remoteName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "swift"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", remoteName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualGitLfsRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-gitlfs-repo", "artifactory_virtual_gitlfs_repository")
+	_, _, remoteName := mkNames("gitlfs-remote", "artifactory_remote_gitlfs_repository")
+	_, _, localName := mkNames("gitlfs-local", "artifactory_local_gitlfs_repository")
+	const template = `
+		resource "artifactory_remote_gitlfs_repository" "%[2]s" {
+		  key = "%[2]s"
+		  url = "https://github.com/"
+		}
+
+		resource "artifactory_local_gitlfs_repository" "%[3]s" {
+		  key = "%[3]s"
+		}
+
+		resource "artifactory_virtual_gitlfs_repository" "%[1]s" {
+		  key                     = "%[1]s"
+		  repositories            = [%[4]s]
+		  default_deployment_repo = artifactory_local_gitlfs_repository.%[3]s.key
+		}
+	`
+	members := fmt.Sprintf("artifactory_local_gitlfs_repository.%s.key, artifactory_remote_gitlfs_repository.%s.key", localName, remoteName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, remoteName, localName, members),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "gitlfs"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", localName),
+					resource.TestCheckResourceAttr(fqrn, "repositories.1", remoteName),
+					resource.TestCheckResourceAttr(fqrn, "default_deployment_repo", localName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChefRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-chef-repo", "artifactory_virtual_chef_repository")
+	_, _, remoteName := mkNames("chef-remote", "artifactory_remote_repository")
+	_, _, localName := mkNames("chef-local", "artifactory_local_chef_repository")
+	const template = `
+		resource "artifactory_remote_repository" "%[2]s" {
+		  key          = "%[2]s"
+		  package_type = "chef"
+		  url          = "https://supermarket.chef.io/"
+		}
+
+		resource "artifactory_local_chef_repository" "%[3]s" {
+		  key = "%[3]s"
+		}
+
+		resource "artifactory_virtual_chef_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = [%[4]s]
+		}
+	`
+	members := fmt.Sprintf("artifactory_local_chef_repository.%s.key, artifactory_remote_repository.%s.key", localName, remoteName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, remoteName, localName, members),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "chef"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.0", localName),
+					resource.TestCheckResourceAttr(fqrn, "repositories.1", remoteName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualBowerRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-bower-repo", "artifactory_virtual_bower_repository")
+	_, _, remoteName := mkNames("bower-remote", "artifactory_remote_bower_repository")
+	const template = `
+		resource "artifactory_remote_bower_repository" "%[2]s" {
+		  key = "%[2]s"
+		  url = "https://github.com/"
+		}
+
+		resource "artifactory_virtual_bower_repository" "%[1]s" {
+		  key                               = "%[1]s"
+		  repositories                      = [%[3]s]
+		  external_dependencies_enabled     = true
+		  external_dependencies_patterns    = ["**/github.com/**"]
+		  external_dependencies_remote_repo = artifactory_remote_bower_repository.%[2]s.key
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, remoteName, fmt.Sprintf("artifactory_remote_bower_repository.%s.key", remoteName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "bower"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "bower-default"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.0", "**/github.com/**"),
+					resource.TestCheckResourceAttr(fqrn, "external_dependencies_remote_repo", remoteName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualAlpineRepository(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-alpine-repo", "artifactory_virtual_alpine_repository")
+	const template = `
+		resource "artifactory_virtual_alpine_repository" "%[1]s" {
+		  key          = "%[1]s"
+		  repositories = []
+		  %[2]s
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(template, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "alpine"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(template, name, fmt.Sprintf(`primary_keypair_ref = "%s-missing-keypair"`, name)),
+				ExpectError: regexp.MustCompile(".*primary_keypair_ref references keypair .* which does not exist.*"),
+			},
+		},
+	})
+}
+
 func TestAccVirtualRpmRepository(t *testing.T) {
 	_, fqrn, name := mkNames("virtual-rpm-repo", "artifactory_virtual_rpm_repository")
 	kpId, kpFqrn, kpName := mkNames("some-keypair1-", "artifactory_keypair")
@@ -416,6 +1217,7 @@ func TestAccVirtualRpmRepository(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "key", name),
 					resource.TestCheckResourceAttr(fqrn, "package_type", "rpm"),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", "simple-default"),
 					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", kpName),
 					resource.TestCheckResourceAttr(fqrn, "secondary_keypair_ref", kpName2),
 				),
@@ -424,6 +1226,29 @@ func TestAccVirtualRpmRepository(t *testing.T) {
 	})
 }
 
+func TestAccVirtualRpmRepositoryWithMissingKeypair(t *testing.T) {
+	_, fqrn, name := mkNames("virtual-rpm-repo", "artifactory_virtual_rpm_repository")
+	virtualRepository := fmt.Sprintf(`
+		resource "artifactory_virtual_rpm_repository" "%s" {
+		  key                 = "%s"
+		  primary_keypair_ref = "%s-missing-keypair"
+		}
+	`, name, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+		ProviderFactories: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      virtualRepository,
+				ExpectError: regexp.MustCompile(".*primary_keypair_ref references keypair .* which does not exist.*"),
+			},
+		},
+	})
+}
+
 func TestAccVirtualRepository_update(t *testing.T) {
 	id := randomInt()
 	name := fmt.Sprintf("foo%d", id)
@@ -471,6 +1296,38 @@ func TestAccVirtualRepository_update(t *testing.T) {
 		},
 	})
 }
+func TestAccVirtualRepositoryRejectsOtherPackageTypes(t *testing.T) {
+	for _, packageType := range []string{"alpine", "bower", "cargo", "chef", "conan", "conda", "gitlfs", "swift", "terraform"} {
+		t.Run(packageType, func(t *testing.T) {
+			_, fqrn, name := mkNames(fmt.Sprintf("virtual-%s-repo", packageType), fmt.Sprintf("artifactory_virtual_%s_repository", packageType))
+			_, _, localName := mkNames("generic-local", "artifactory_local_generic_repository")
+			config := fmt.Sprintf(`
+				resource "artifactory_local_generic_repository" "%[2]s" {
+				  key = "%[2]s"
+				}
+
+				resource "artifactory_virtual_%[3]s_repository" "%[1]s" {
+				  key          = "%[1]s"
+				  repositories = [artifactory_local_generic_repository.%[2]s.key]
+				}
+			`, name, localName, packageType)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
+				ProviderFactories: testAccProviders,
+
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: regexp.MustCompile(fmt.Sprintf(".*has package type generic, only %s repositories can be included.*", packageType)),
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAllPackageTypes(t *testing.T) {
 	for _, repo := range repoTypesSupported {
 		if repo != "nuget" { // this requires special testing
@@ -1,11 +1,12 @@
 package artifactory
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var rpmVirtualSchema = mergeSchema(baseVirtualRepoSchema, map[string]*schema.Schema{
+var rpmVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "rpm"), map[string]*schema.Schema{
 	"primary_keypair_ref": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -31,7 +32,7 @@ type RpmVirtualRepositoryParams struct {
 }
 
 func resourceArtifactoryRpmVirtualRepository() *schema.Resource {
-	return mkResourceSchema(rpmVirtualSchema, defaultPacker, unpackRpmVirtualRepository, func() interface{} {
+	resource := mkResourceSchema(rpmVirtualSchema, defaultPacker, unpackRpmVirtualRepository, func() interface{} {
 		return &RpmVirtualRepositoryParams{
 			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
 				Rclass:      "virtual",
@@ -39,6 +40,13 @@ func resourceArtifactoryRpmVirtualRepository() *schema.Resource {
 			},
 		}
 	})
+	resource.CustomizeDiff = customdiff.All(
+		resource.CustomizeDiff,
+		mkRepositoriesPackageTypeDiff("rpm"),
+		mkKeyPairExistsDiff("primary_keypair_ref", "secondary_keypair_ref"),
+	)
+
+	return resource
 }
 
 func unpackRpmVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
@@ -0,0 +1,33 @@
+package artifactory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var swiftVirtualSchema = mergeSchema(baseVirtualRepoSchema, repoLayoutRefSchema("virtual", "swift"))
+
+type SwiftVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+}
+
+func resourceArtifactorySwiftVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(swiftVirtualSchema, defaultPacker, unpackSwiftVirtualRepository, func() interface{} {
+		return &SwiftVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "swift",
+			},
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, mkRepositoriesPackageTypeDiff("swift"))
+
+	return resource
+}
+
+func unpackSwiftVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	repo := SwiftVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "swift"),
+	}
+	return repo, repo.Id(), nil
+}
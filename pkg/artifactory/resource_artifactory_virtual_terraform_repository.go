@@ -0,0 +1,83 @@
+package artifactory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// terraformRepoLayoutRefs are the default layouts of the Terraform repository sub-types
+var terraformRepoLayoutRefs = map[string]string{
+	"module":   "terraform-module-default",
+	"provider": "terraform-provider-default",
+}
+
+var terraformVirtualSchema = mergeSchema(baseVirtualRepoSchema, map[string]*schema.Schema{
+	"terraform_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "module",
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{"module", "provider"}, false),
+		Description: "Whether the repository aggregates Terraform modules or providers, one of 'module' or 'provider'. " +
+			"The default repo_layout_ref follows it. Default value is 'module'.",
+	},
+})
+
+type TerraformVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+	TerraformType string `hcl:"terraform_type" json:"terraformType"`
+}
+
+func resourceArtifactoryTerraformVirtualRepository() *schema.Resource {
+	resource := mkResourceSchema(terraformVirtualSchema, defaultPacker, unpackTerraformVirtualRepository, func() interface{} {
+		return &TerraformVirtualRepositoryParams{
+			VirtualRepositoryBaseParams: VirtualRepositoryBaseParams{
+				Rclass:      "virtual",
+				PackageType: "terraform",
+			},
+			TerraformType: "module",
+		}
+	})
+	resource.CustomizeDiff = customdiff.All(
+		resource.CustomizeDiff,
+		mkRepositoriesPackageTypeDiff("terraform"),
+		terraformVirtualTypeDiff,
+	)
+
+	return resource
+}
+
+// terraformVirtualTypeDiff rejects local members of the other sub-type. Remote registry mirrors serve both modules and
+// providers, so they can be aggregated by either
+func terraformVirtualTypeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	terraformType := diff.Get("terraform_type").(string)
+	for _, member := range castToStringArr(diff.Get("repositories").([]interface{})) {
+		if member == "" {
+			continue
+		}
+		repo, err := getRepositoryDetails(m.(*resty.Client), member)
+		if err == nil && repo.PackageType == "terraform" && repo.TerraformType != "" && repo.TerraformType != terraformType {
+			return fmt.Errorf("repository %s holds Terraform %ss, only %s repositories can be included", member, repo.TerraformType, terraformType)
+		}
+	}
+
+	return nil
+}
+
+func unpackTerraformVirtualRepository(s *schema.ResourceData) (interface{}, string, error) {
+	d := &ResourceData{s}
+	repo := TerraformVirtualRepositoryParams{
+		VirtualRepositoryBaseParams: unpackBaseVirtRepo(s, "terraform"),
+		TerraformType:               d.getString("terraform_type", false),
+	}
+	if repo.RepoLayoutRef == "" {
+		repo.RepoLayoutRef = terraformRepoLayoutRefs[repo.TerraformType]
+	}
+
+	return repo, repo.Id(), nil
+}
@@ -31,18 +31,38 @@ var webhookTypesSupported = []string{
 	"release_bundle",
 	"distribution",
 	"artifactory_release_bundle",
+	"release_bundle_v2",
 }
 
 var domainEventTypesSupported = map[string][]string{
-	"artifact": []string{"deployed", "deleted", "moved", "copied"},
-	"artifact_property": []string{"added", "deleted"},
-	"docker": []string{"pushed", "deleted", "promoted"},
-	"build": []string{"uploaded", "deleted", "promoted"},
-	"release_bundle": []string{"created", "signed", "deleted"},
-	"distribution": []string{"distribute_started", "distribute_completed", "distribute_aborted", "distribute_failed", "delete_started", "delete_completed", "delete_failed"},
+	"artifact":                   []string{"deployed", "deleted", "moved", "copied"},
+	"artifact_property":          []string{"added", "deleted"},
+	"docker":                     []string{"pushed", "deleted", "promoted"},
+	"build":                      []string{"uploaded", "deleted", "promoted"},
+	"release_bundle":             []string{"created", "signed", "deleted"},
+	"distribution":               []string{"distribute_started", "distribute_completed", "distribute_aborted", "distribute_failed", "delete_started", "delete_completed", "delete_failed"},
 	"artifactory_release_bundle": []string{"received", "delete_started", "delete_completed", "delete_failed"},
+	"release_bundle_v2":          []string{"release_bundle_v2_started", "release_bundle_v2_completed", "release_bundle_v2_failed"},
 }
 
+// domainCriteriaPackLookup turns the criteria returned by Artifactory into the criteria block of the domain. It is shared
+// with the webhook data source
+var domainCriteriaPackLookup = map[string]func(map[string]interface{}) map[string]interface{}{
+	"artifact":                   packRepoCriteria,
+	"artifact_property":          packRepoCriteria,
+	"docker":                     packRepoCriteria,
+	"build":                      packBuildCriteria,
+	"release_bundle":             packReleaseBundleCriteria,
+	"distribution":               packReleaseBundleCriteria,
+	"artifactory_release_bundle": packReleaseBundleCriteria,
+	"release_bundle_v2":          packReleaseBundleV2Criteria,
+}
+
+// webhookEventTypesVerifiedVersion is the latest Artifactory version domainEventTypesSupported has been checked against.
+// Newer instances may support event types this provider doesn't know about yet, so for those an unknown event type
+// is only logged and left for Artifactory to validate. Bump this whenever the map above is brought up to date.
+const webhookEventTypesVerifiedVersion = "7.63.0"
+
 type WebhookBaseParams struct {
 	Key         string             `json:"key"`
 	Description string             `json:"description"`
@@ -88,6 +108,7 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 		"release_bundle":             ReleaseBundleWebhookCriteria{},
 		"distribution":               ReleaseBundleWebhookCriteria{},
 		"artifactory_release_bundle": ReleaseBundleWebhookCriteria{},
+		"release_bundle_v2":          ReleaseBundleV2WebhookCriteria{},
 	}
 
 	var domainSchemaLookup = map[string]map[string]*schema.Schema{
@@ -98,16 +119,7 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 		"release_bundle":             releaseBundleWebhookSchema(webhookType),
 		"distribution":               releaseBundleWebhookSchema(webhookType),
 		"artifactory_release_bundle": releaseBundleWebhookSchema(webhookType),
-	}
-
-	var domainPackLookup = map[string]func(map[string]interface{}) map[string]interface{}{
-		"artifact":                   packRepoCriteria,
-		"artifact_property":          packRepoCriteria,
-		"docker":                     packRepoCriteria,
-		"build":                      packBuildCriteria,
-		"release_bundle":             packReleaseBundleCriteria,
-		"distribution":               packReleaseBundleCriteria,
-		"artifactory_release_bundle": packReleaseBundleCriteria,
+		"release_bundle_v2":          releaseBundleV2WebhookSchema(webhookType),
 	}
 
 	var domainUnpackLookup = map[string]func(map[string]interface{}, BaseWebhookCriteria) interface{}{
@@ -118,6 +130,7 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 		"release_bundle":             unpackReleaseBundleCriteria,
 		"distribution":               unpackReleaseBundleCriteria,
 		"artifactory_release_bundle": unpackReleaseBundleCriteria,
+		"release_bundle_v2":          unpackReleaseBundleV2Criteria,
 	}
 
 	var unpackWebhook = func(data *schema.ResourceData) (WebhookBaseParams, error) {
@@ -188,7 +201,7 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 		setValue := mkLens(d)
 
 		resource := domainSchemaLookup[webhookType]["criteria"].Elem.(*schema.Resource)
-		packedCriteria := domainPackLookup[webhookType](criteria)
+		packedCriteria := domainCriteriaPackLookup[webhookType](criteria)
 
 		packedCriteria["include_patterns"] = schema.NewSet(schema.HashString, criteria["includePatterns"].([]interface{}))
 		packedCriteria["exclude_patterns"] = schema.NewSet(schema.HashString, criteria["excludePatterns"].([]interface{}))
@@ -221,7 +234,7 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 
 		handler := webhook.Handlers[0]
 		errors = append(errors, setValue("url", handler.Url)...)
-		errors = append(errors, setValue("secret", handler.Secret)...)
+		errors = append(errors, packWebhookSecret(d, handler.Secret)...)
 		errors = append(errors, setValue("proxy", handler.Proxy)...)
 
 		errors = append(errors, packCustomHeaders(d, handler.CustomHttpHeaders)...)
@@ -324,6 +337,7 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 		"release_bundle":             releaseBundleCriteriaValidation,
 		"distribution":               releaseBundleCriteriaValidation,
 		"artifactory_release_bundle": releaseBundleCriteriaValidation,
+		"release_bundle_v2":          releaseBundleV2CriteriaValidation,
 	}
 
 	var eventTypesDiff = func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -331,14 +345,29 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 
 		eventTypes := diff.Get("event_types").(*schema.Set).List()
 		if len(eventTypes) == 0 {
+			// MinItems isn't enforced for an empty set in the configuration, and the API only rejects it on apply
+			if diff.NewValueKnown("event_types") {
+				return fmt.Errorf("at least one event_type is required")
+			}
 			return nil
 		}
 
 		eventTypesSupported := domainEventTypesSupported[webhookType]
 		for _, eventType := range eventTypes {
-			if !contains(eventTypesSupported, eventType.(string)) {
-				return fmt.Errorf("event_type %s not supported for domain %s", eventType, webhookType)
+			if contains(eventTypesSupported, eventType.(string)) {
+				continue
+			}
+
+			// Artifactory has no endpoint listing the event types it supports, so fall back on its version to tell
+			// whether the map above may simply be out of date. If so the event type is passed through, as documented,
+			// and Artifactory rejects it on apply if it doesn't support it either
+			version, err := getArtifactoryVersion(v.(*resty.Client))
+			if err == nil && isNewerVersion(version, webhookEventTypesVerifiedVersion) {
+				log.Printf("[DEBUG] event_type %s is unknown for domain %s as of Artifactory %s, passing it through to Artifactory %s",
+					eventType, webhookType, webhookEventTypesVerifiedVersion, version)
+				continue
 			}
+			return fmt.Errorf("event_type %s not supported for domain %s", eventType, webhookType)
 		}
 		return nil
 	}
@@ -365,11 +394,11 @@ func resourceArtifactoryWebhook(webhookType string) *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema:        domainSchemaLookup[webhookType],
+		Schema: domainSchemaLookup[webhookType],
 		CustomizeDiff: customdiff.All(
 			eventTypesDiff,
 			criteriaDiff,
 		),
-		Description:   "Provides an Artifactory webhook resource",
+		Description: "Provides an Artifactory webhook resource",
 	}
 }
@@ -49,12 +49,13 @@ var baseWebhookBaseSchema = func(webhookType string) map[string]*schema.Schema {
 			Description: "Status of webhook. Default to 'true'",
 		},
 		"event_types": {
-			Type:        schema.TypeSet,
-			Required:    true,
-			MinItems:    1,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: fmt.Sprintf("List of Events in Artifactory, Distribution, Release Bundle that function as the event trigger for the Webhook.\n" +
-			"Allow values: %v", strings.Trim(strings.Join(domainEventTypesSupported[webhookType], ", "), "[]")),
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: fmt.Sprintf("List of Events in Artifactory, Distribution, Release Bundle that function as the event trigger for the Webhook.\n"+
+				"Allow values: %v. On Artifactory versions newer than %s, other event types are passed through for Artifactory to validate.",
+				strings.Trim(strings.Join(domainEventTypesSupported[webhookType], ", "), "[]"), webhookEventTypesVerifiedVersion),
 		},
 		"url": {
 			Type:             schema.TypeString,
@@ -82,3 +83,13 @@ var baseWebhookBaseSchema = func(webhookType string) map[string]*schema.Schema {
 		},
 	}
 }
+
+// packWebhookSecret is shared by all the webhook domains. Artifactory doesn't hand the secret back as it was set, it is
+// left out or masked once stored, so the secret in the state is kept then. Otherwise every plan would show it as changed
+func packWebhookSecret(d *schema.ResourceData, secret string) []error {
+	if strings.Trim(secret, "*") == "" {
+		return nil
+	}
+
+	return mkLens(d)("secret", secret)
+}
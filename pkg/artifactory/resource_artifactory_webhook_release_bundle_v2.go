@@ -0,0 +1,68 @@
+package artifactory
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ReleaseBundleV2WebhookCriteria struct {
+	BaseWebhookCriteria
+	AnyReleaseBundle       bool     `json:"anyReleaseBundle"`
+	SelectedReleaseBundles []string `json:"selectedReleaseBundles"`
+}
+
+var releaseBundleV2WebhookSchema = func(webhookType string) map[string]*schema.Schema {
+	return mergeSchema(baseWebhookBaseSchema(webhookType), map[string]*schema.Schema{
+		"criteria": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: mergeSchema(baseCriteriaSchema, map[string]*schema.Schema{
+					"any_release_bundle": {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Trigger on any release bundles v2",
+					},
+					"selected_release_bundles": {
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Trigger on this list of release bundle v2 names",
+					},
+				}),
+			},
+			Description: "Specifies where the webhook will be applied, on which release bundles v2.",
+		},
+	})
+}
+
+var packReleaseBundleV2Criteria = func(artifactoryCriteria map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"any_release_bundle":       artifactoryCriteria["anyReleaseBundle"].(bool),
+		"selected_release_bundles": schema.NewSet(schema.HashString, artifactoryCriteria["selectedReleaseBundles"].([]interface{})),
+	}
+}
+
+var unpackReleaseBundleV2Criteria = func(terraformCriteria map[string]interface{}, baseCriteria BaseWebhookCriteria) interface{} {
+	return ReleaseBundleV2WebhookCriteria{
+		AnyReleaseBundle:       terraformCriteria["any_release_bundle"].(bool),
+		SelectedReleaseBundles: castToStringArr(terraformCriteria["selected_release_bundles"].(*schema.Set).List()),
+		BaseWebhookCriteria:    baseCriteria,
+	}
+}
+
+var releaseBundleV2CriteriaValidation = func(criteria map[string]interface{}) error {
+	log.Print("[DEBUG] releaseBundleV2CriteriaValidation")
+
+	anyReleaseBundle := criteria["any_release_bundle"].(bool)
+	selectedReleaseBundles := criteria["selected_release_bundles"].(*schema.Set).List()
+
+	if anyReleaseBundle == false && len(selectedReleaseBundles) == 0 {
+		return fmt.Errorf("selected_release_bundles cannot be empty when any_release_bundle is false")
+	}
+
+	return nil
+}
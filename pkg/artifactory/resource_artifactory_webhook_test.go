@@ -1,13 +1,18 @@
 package artifactory
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var domainRepoTypeLookup = map[string]string{
@@ -24,6 +29,7 @@ var domainValidationErrorMessageLookup = map[string]string{
 	"release_bundle":             "registered_release_bundle_names cannot be empty when any_release_bundle is false",
 	"distribution":               "registered_release_bundle_names cannot be empty when any_release_bundle is false",
 	"artifactory_release_bundle": "registered_release_bundle_names cannot be empty when any_release_bundle is false",
+	"release_bundle_v2":          "selected_release_bundles cannot be empty when any_release_bundle is false",
 }
 
 var repoTemplate = `
@@ -66,6 +72,19 @@ var releaseBundleTemplate = `
 	}
 `
 
+var releaseBundleV2Template = `
+	resource "artifactory_{{ .webhookType }}_webhook" "{{ .webhookName }}" {
+		key         = "{{ .webhookName }}"
+		description = "test description"
+		event_types = [{{ range $index, $eventType := .eventTypes}}{{if $index}},{{end}}"{{$eventType}}"{{end}}]
+		criteria {
+			any_release_bundle = false
+			selected_release_bundles = []
+		}
+		url    = "http://tempurl.org"
+	}
+`
+
 func TestAccWebhookCriteriaValidation(t *testing.T) {
 	for _, webhookType := range webhookTypesSupported {
 		t.Run(fmt.Sprintf("TestWebhook%sCriteriaValidation", strings.Title(strings.ToLower(webhookType))), func(t *testing.T) {
@@ -87,6 +106,8 @@ func webhookCriteriaValidationTestCase(webhookType string, t *testing.T) (*testi
 		template = buildTemplate
 	case "release_bundle", "distribution", "artifactory_release_bundle":
 		template = releaseBundleTemplate
+	case "release_bundle_v2":
+		template = releaseBundleV2Template
 	}
 
 	params := map[string]interface{}{
@@ -110,16 +131,24 @@ func webhookCriteriaValidationTestCase(webhookType string, t *testing.T) (*testi
 	}
 }
 
-func TestAccWebhookEventTypesValidation(t *testing.T) {
+// webhookEventTypesOutdated reports whether the instance is newer than the one domainEventTypesSupported has been
+// verified against, in which case the provider passes unknown event types through to Artifactory
+func webhookEventTypesOutdated(t *testing.T) bool {
+	version, err := getArtifactoryVersion(getTestResty(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return isNewerVersion(version, webhookEventTypesVerifiedVersion)
+}
+
+func webhookWrongEventTypeTestCase(t *testing.T, expectedError *regexp.Regexp) resource.TestCase {
 	id := randomInt()
 	name := fmt.Sprintf("webhook-%d", id)
 	fqrn := fmt.Sprintf("artifactory_artifact_webhook.%s", name)
 
-	wrongEventType := "wrong-event-type"
-
 	params := map[string]interface{}{
 		"webhookName": name,
-		"eventType":   wrongEventType,
+		"eventType":   "wrong-event-type",
 	}
 	webhookConfig := executeTemplate("TestAccWebhookEventTypesValidation", `
 		resource "artifactory_artifact_webhook" "{{ .webhookName }}" {
@@ -135,7 +164,7 @@ func TestAccWebhookEventTypesValidation(t *testing.T) {
 		}
 	`, params)
 
-	resource.Test(t, resource.TestCase{
+	return resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		CheckDestroy:      verifyDeleted(fqrn, testCheckRepo),
 		ProviderFactories: testAccProviders,
@@ -143,7 +172,51 @@ func TestAccWebhookEventTypesValidation(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      webhookConfig,
-				ExpectError: regexp.MustCompile(fmt.Sprintf("event_type %s not supported for domain artifact", wrongEventType)),
+				ExpectError: expectedError,
+			},
+		},
+	}
+}
+
+func TestAccWebhookEventTypesValidation(t *testing.T) {
+	if webhookEventTypesOutdated(t) {
+		t.Skipf("Artifactory is newer than %s, unknown event types are passed through", webhookEventTypesVerifiedVersion)
+	}
+
+	resource.Test(t, webhookWrongEventTypeTestCase(t, regexp.MustCompile("event_type wrong-event-type not supported for domain artifact")))
+}
+
+func TestAccWebhookEventTypesPassedThrough(t *testing.T) {
+	if !webhookEventTypesOutdated(t) {
+		t.Skipf("Artifactory isn't newer than %s, unknown event types are rejected at plan time", webhookEventTypesVerifiedVersion)
+	}
+
+	// the provider doesn't know better, so the event type is only rejected by Artifactory when the webhook is created
+	resource.Test(t, webhookWrongEventTypeTestCase(t, regexp.MustCompile(`400 POST \S+`+regexp.QuoteMeta(webhooksUrl))))
+}
+
+func TestAccWebhookEmptyEventTypes(t *testing.T) {
+	_, _, name := mkNames("webhook", "artifactory_artifact_webhook")
+	webhookConfig := fmt.Sprintf(`
+		resource "artifactory_artifact_webhook" "%[1]s" {
+			key         = "%[1]s"
+			event_types = []
+			criteria {
+				any_local  = true
+				any_remote = true
+				repo_keys  = []
+			}
+			url = "http://tempurl.org"
+		}
+	`, name)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      webhookConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("at least one event_type is required"),
 			},
 		},
 	})
@@ -238,10 +311,80 @@ func webhookTestCase(webhookType string, t *testing.T) (*testing.T, resource.Tes
 				Config: webhookConfig,
 				Check:  resource.ComposeTestCheckFunc(testChecks...),
 			},
+			{
+				// Artifactory doesn't return the secret, which must not show up as a change to apply again
+				Config:             webhookConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 		},
 	}
 }
 
+func TestWebhookSecretIsKeptOnRead(t *testing.T) {
+	repoCriteria := map[string]interface{}{"anyLocal": true, "anyRemote": false, "repoKeys": []string{}}
+	releaseBundleCriteria := map[string]interface{}{"anyReleaseBundle": true, "registeredReleaseBundlesNames": []string{}}
+	domainCriteria := map[string]map[string]interface{}{
+		"artifact":                   repoCriteria,
+		"artifact_property":          repoCriteria,
+		"docker":                     repoCriteria,
+		"build":                      {"anyBuild": true, "selectedBuilds": []string{}},
+		"release_bundle":             releaseBundleCriteria,
+		"distribution":               releaseBundleCriteria,
+		"artifactory_release_bundle": releaseBundleCriteria,
+		"release_bundle_v2":          {"anyReleaseBundle": true, "selectedReleaseBundles": []string{}},
+	}
+
+	for _, webhookType := range webhookTypesSupported {
+		for _, returnedSecret := range []string{"", "*****"} {
+			t.Run(fmt.Sprintf("%s/%q", webhookType, returnedSecret), func(t *testing.T) {
+				criteria := map[string]interface{}{"includePatterns": []string{}, "excludePatterns": []string{}}
+				for key, value := range domainCriteria[webhookType] {
+					criteria[key] = value
+				}
+				webhook := map[string]interface{}{
+					"key":     "webhook",
+					"enabled": true,
+					"event_filter": map[string]interface{}{
+						"domain":      webhookType,
+						"event_types": domainEventTypesSupported[webhookType][:1],
+						"criteria":    criteria,
+					},
+					"handlers": []map[string]interface{}{
+						{"handler_type": "webhook", "url": "http://tempurl.org", "secret": returnedSecret},
+					},
+				}
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode(webhook)
+				}))
+				defer server.Close()
+
+				client, err := buildResty(server.URL)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				webhookResource := resourceArtifactoryWebhook(webhookType)
+				d := schema.TestResourceDataRaw(t, webhookResource.Schema, map[string]interface{}{
+					"key":         "webhook",
+					"url":         "http://tempurl.org",
+					"secret":      "fake-secret",
+					"event_types": []interface{}{domainEventTypesSupported[webhookType][0]},
+				})
+				d.SetId("webhook")
+				if diags := webhookResource.ReadContext(context.Background(), d, client.SetRetryCount(0)); diags.HasError() {
+					t.Fatalf("failed to read the webhook: %v", diags)
+				}
+
+				if secret := d.Get("secret"); secret != "fake-secret" {
+					t.Errorf("expected the secret to be kept, got %q", secret)
+				}
+			})
+		}
+	}
+}
+
 func testCheckWebhook(id string, request *resty.Request) (*resty.Response, error) {
 	return request.
 		SetPathParam("webhookKey", id).
@@ -15,6 +15,8 @@ import (
 // and it's totally inconsistent with the rest of the code.
 // Option are: move this code into the terraform space, as is, or beg the jfrog-go-client
 // team to captial case those variables. I ticket will be filed, but I am not hopeful.
+var watchResourceTypesSupported = []string{"repository", "all-repos", "build", "all-builds", "project", "all-projects"}
+
 type WatchGeneralData struct {
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
@@ -94,8 +96,9 @@ func resourceXrayWatch() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(watchResourceTypesSupported, false),
 						},
 						"name": {
 							Type:     schema.TypeString,
@@ -332,6 +335,9 @@ func resourceXrayWatchRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := d.Set("name", watch.GeneralData.Name); err != nil {
+		return err
+	}
 	if err := d.Set("description", watch.GeneralData.Description); err != nil {
 		return err
 	}
@@ -1,10 +1,13 @@
 package artifactory
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v2"
+	"io/ioutil"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -202,3 +205,50 @@ var deleteProxy = func(t *testing.T, proxyKey string) {
 		return []byte(`proxies: ~`)
 	})
 }
+
+// fakeRepository is the repository held by a server from mkFakeRepositoryServer
+type fakeRepository struct {
+	// saved is the body of the last PUT or POST, less the password
+	saved map[string]interface{}
+	// password is the password of the last PUT or POST, Artifactory never returns it
+	password interface{}
+	// responses are returned on GET of the other paths, relative to the Artifactory url
+	responses map[string]interface{}
+}
+
+// mkFakeRepositoryServer serves the repository configuration API for a single repository: the last configuration
+// saved is returned on GET, any other path is not found unless given in responses. The client returned doesn't retry
+func mkFakeRepositoryServer(t *testing.T, key string) (*resty.Client, *fakeRepository) {
+	repo := &fakeRepository{responses: map[string]interface{}{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/repositories/"+key {
+			response, ok := repo.responses[strings.TrimPrefix(r.URL.Path, "/artifactory/")]
+			if !ok || r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+			return
+		}
+		if r.Method == http.MethodPut || r.Method == http.MethodPost {
+			repo.saved = map[string]interface{}{}
+			body, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &repo.saved); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			repo.password = repo.saved["password"]
+			delete(repo.saved, "password")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(repo.saved)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := buildResty(server.URL + "/artifactory/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.SetRetryCount(0), repo
+}
@@ -5,6 +5,7 @@ import (
 	"net/mail"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/gorhill/cronexpr"
@@ -30,9 +31,53 @@ func validateCron(value interface{}, key string) (ws []string, es []error) {
 	return nil, nil
 }
 
-var commaSeperatedList = validation.ToDiagFunc(
-	validation.StringMatch(regexp.MustCompile(`.+(?:,.+)*`), "must be comma separated string"),
-)
+// splitCommaSeperatedList returns the elements of a comma separated string, trimmed and without the empty ones
+func splitCommaSeperatedList(value string) []string {
+	var elements []string
+	for _, element := range strings.Split(value, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+func validateCommaSeperatedList(value interface{}, key string) ([]string, []error) {
+	seen := map[string]bool{}
+	for _, element := range splitCommaSeperatedList(value.(string)) {
+		if seen[element] {
+			return nil, []error{fmt.Errorf("%s must not contain duplicates, %q is listed more than once", key, element)}
+		}
+		seen[element] = true
+	}
+	return nil, nil
+}
+
+var commaSeperatedList = validation.ToDiagFunc(validateCommaSeperatedList)
+
+// normalizeCommaSeperatedList is the StateFunc of the attributes validated by commaSeperatedList, so that spacing and
+// ordering of the elements don't show up as a diff
+func normalizeCommaSeperatedList(value interface{}) string {
+	elements := splitCommaSeperatedList(value.(string))
+	sort.Strings(elements)
+	return strings.Join(elements, ",")
+}
+
+var audienceRegex = regexp.MustCompile(`^(\*|[a-z]+@(\*|[a-zA-Z0-9]+))$`)
+
+// validateAudience checks that every space separated entry of an access token audience is either '*' or a
+// <serviceType>@<serviceId> pair such as 'jfrt@*' or 'jfrt@01e3qw4s5rbhpy1dmbxbm91qbd'
+func validateAudience(value interface{}, key string) ([]string, []error) {
+	for _, audience := range strings.Fields(value.(string)) {
+		if !audienceRegex.MatchString(audience) {
+			return nil, []error{fmt.Errorf(
+				"%s entry %q is malformed, expected a space separated list of <serviceType>@<serviceId> (e.g. 'jfrt@*' or 'jfrt@01e3qw4s5rbhpy1dmbxbm91qbd') or '*'",
+				key, audience,
+			)}
+		}
+	}
+	return nil, nil
+}
 
 var validLicenseTypes = []string{
 	"0BSD",
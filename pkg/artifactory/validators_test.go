@@ -0,0 +1,34 @@
+package artifactory
+
+import (
+	"testing"
+)
+
+func TestCommaSeperatedList(t *testing.T) {
+	if normalized := normalizeCommaSeperatedList(" b, a ,, c "); normalized != "a,b,c" {
+		t.Errorf("expected a,b,c, got %s", normalized)
+	}
+	if normalized := normalizeCommaSeperatedList(""); normalized != "" {
+		t.Errorf("expected an empty string, got %s", normalized)
+	}
+
+	if _, errs := validateCommaSeperatedList("a, b ,c", "list"); len(errs) != 0 {
+		t.Errorf("expected no error, got %v", errs)
+	}
+	if _, errs := validateCommaSeperatedList("a, a ,b", "list"); len(errs) != 1 {
+		t.Errorf("expected a duplicate error, got %v", errs)
+	}
+}
+
+func TestValidateAudience(t *testing.T) {
+	for _, audience := range []string{"jfrt@*", "*", "jfrt@01e3qw4s5rbhpy1dmbxbm91qbd jfxr@*"} {
+		if _, errs := validateAudience(audience, "audience"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", audience, errs)
+		}
+	}
+	for _, audience := range []string{"bogus", "jfrt@", "jfrt@* bogus"} {
+		if _, errs := validateAudience(audience, "audience"); len(errs) != 1 {
+			t.Errorf("expected %q to be rejected, got %v", audience, errs)
+		}
+	}
+}